@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// ErrNotBusiness is returned when a business tool is used with a regular account
+var ErrNotBusiness = errors.New("account is not a business account")
+
+// ErrCatalogNotFound is returned when a business has no catalog
+var ErrCatalogNotFound = errors.New("business has no catalog")
+
+// businessQueryTimeout limits how long to wait for WhatsApp to answer a business query
+const businessQueryTimeout = 30 * time.Second
+
+// lookupBusinessProfile is the lookup cache kind of business profiles
+const lookupBusinessProfile = "business_profile"
+
+// Versions of the business queries, as sent by WhatsApp Web. Update them when WhatsApp Web bumps them
+// and the server stops answering or drops fields.
+const (
+	// businessProfileVersion is the "v" attribute of business_profile queries
+	businessProfileVersion = "244"
+	// businessCollectionsSmaxID is the "smax_id" attribute of catalog collections queries
+	businessCollectionsSmaxID = "35"
+)
+
+// catalogImageSize is the width and height in pixels of product image previews requested with catalogs,
+// the size WhatsApp Web requests
+const catalogImageSize = "100"
+
+// GetBusinessProfile returns the description, address, email, websites, categories and opening hours of a business
+// whatsmeow's own GetBusinessProfile drops the description and websites, so the profile is queried directly
+// Results are cached in the database and reused until they are older than the cache TTL, unless refresh is set
+func (wc *WhatsmeowClient) GetBusinessProfile(ctx context.Context, jid string, refresh bool) (*types.BusinessProfileResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	business, err := parseBusinessJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.BusinessProfileResponse
+	if !refresh && wc.cachedLookup(ctx, lookupBusinessProfile, business.String(), &response) {
+		return &response, nil
+	}
+
+	resp, err := wc.queryServer(ctx, "w:biz", nil, waBinary.Node{
+		Tag:   "business_profile",
+		Attrs: waBinary.Attrs{"v": businessProfileVersion},
+		Content: []waBinary.Node{{
+			Tag:   "profile",
+			Attrs: waBinary.Attrs{"jid": business},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get business profile: %w", err)
+	}
+
+	// Regular accounts have no profile in the response
+	businessProfile := resp.GetChildByTag("business_profile")
+	profile, ok := businessProfile.GetOptionalChildByTag("profile")
+	if !ok {
+		return nil, ErrNotBusiness
+	}
+	if _, ok := profile.AttrGetter().GetJID("jid", false); !ok {
+		return nil, ErrNotBusiness
+	}
+
+	response = types.BusinessProfileResponse{
+		Success:     true,
+		JID:         business.String(),
+		Description: nodeText(profile, "description"),
+		Address:     nodeText(profile, "address"),
+		Email:       nodeText(profile, "email"),
+		Websites:    []string{},
+		Categories:  []types.BusinessCategory{},
+		Hours:       []types.BusinessHours{},
+		FetchedAt:   time.Now().Unix(),
+	}
+	for _, website := range profile.GetChildrenByTag("website") {
+		if url, _ := website.Content.([]byte); len(url) > 0 {
+			response.Websites = append(response.Websites, string(url))
+		}
+	}
+	categories := profile.GetChildByTag("categories")
+	for _, category := range categories.GetChildrenByTag("category") {
+		name, _ := category.Content.([]byte)
+		response.Categories = append(response.Categories, types.BusinessCategory{
+			ID:   category.AttrGetter().OptionalString("id"),
+			Name: string(name),
+		})
+	}
+	hours := profile.GetChildByTag("business_hours")
+	response.HoursTimezone = hours.AttrGetter().OptionalString("timezone")
+	for _, config := range hours.GetChildrenByTag("business_hours_config") {
+		ag := config.AttrGetter()
+		response.Hours = append(response.Hours, types.BusinessHours{
+			DayOfWeek: ag.OptionalString("day_of_week"),
+			Mode:      ag.OptionalString("mode"),
+			OpenTime:  ag.OptionalString("open_time"),
+			CloseTime: ag.OptionalString("close_time"),
+		})
+	}
+	options := profile.GetChildByTag("profile_options")
+	for _, option := range options.GetChildren() {
+		value, ok := option.Content.([]byte)
+		if !ok {
+			continue
+		}
+		if response.ProfileOptions == nil {
+			response.ProfileOptions = make(map[string]string)
+		}
+		response.ProfileOptions[option.Tag] = string(value)
+	}
+
+	wc.saveLookup(ctx, lookupBusinessProfile, response.JID, response)
+
+	return &response, nil
+}
+
+// GetBusinessCatalog returns a page of the products in the catalog of a business
+// Catalogs are not cached since they are paged and change often
+func (wc *WhatsmeowClient) GetBusinessCatalog(ctx context.Context, jid string, count int, cursor string) (*types.BusinessCatalogResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	business, err := parseBusinessJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := []waBinary.Node{
+		{Tag: "limit", Content: []byte(strconv.Itoa(count))},
+		{Tag: "width", Content: []byte(catalogImageSize)},
+		{Tag: "height", Content: []byte(catalogImageSize)},
+	}
+	if cursor != "" {
+		query = append(query, waBinary.Node{Tag: "after", Content: []byte(cursor)})
+	}
+
+	resp, err := wc.queryServer(ctx, "w:biz:catalog", nil, waBinary.Node{
+		Tag:     "product_catalog",
+		Attrs:   waBinary.Attrs{"jid": business, "allow_shop_source": "true"},
+		Content: query,
+	})
+	if errors.Is(err, whatsmeow.ErrIQNotFound) {
+		return nil, ErrCatalogNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get business catalog: %w", err)
+	}
+
+	catalog := resp.GetChildByTag("product_catalog")
+	response := &types.BusinessCatalogResponse{
+		Success:    true,
+		JID:        business.String(),
+		Products:   parseProducts(catalog),
+		NextCursor: nodeText(catalog.GetChildByTag("paging"), "after"),
+	}
+	response.Count = len(response.Products)
+
+	return response, nil
+}
+
+// GetBusinessCollections returns the collections of a business catalog with their products
+func (wc *WhatsmeowClient) GetBusinessCollections(ctx context.Context, jid string, count int) (*types.BusinessCollectionsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	business, err := parseBusinessJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := []byte(strconv.Itoa(count))
+	resp, err := wc.queryServer(ctx, "w:biz:catalog", waBinary.Attrs{"smax_id": businessCollectionsSmaxID}, waBinary.Node{
+		Tag:   "collections",
+		Attrs: waBinary.Attrs{"biz_jid": business},
+		Content: []waBinary.Node{
+			{Tag: "collection_limit", Content: limit},
+			{Tag: "item_limit", Content: limit},
+			{Tag: "width", Content: []byte(catalogImageSize)},
+			{Tag: "height", Content: []byte(catalogImageSize)},
+		},
+	})
+	if errors.Is(err, whatsmeow.ErrIQNotFound) {
+		return nil, ErrCatalogNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get business collections: %w", err)
+	}
+
+	collections := resp.GetChildByTag("collections")
+	response := &types.BusinessCollectionsResponse{
+		Success:     true,
+		JID:         business.String(),
+		Collections: []types.BusinessCollection{},
+	}
+	for _, collection := range collections.GetChildrenByTag("collection") {
+		response.Collections = append(response.Collections, types.BusinessCollection{
+			ID:           nodeText(collection, "id"),
+			Name:         nodeText(collection, "name"),
+			ReviewStatus: nodeText(collection.GetChildByTag("status_info"), "status"),
+			Products:     parseProducts(collection),
+		})
+	}
+	response.Count = len(response.Collections)
+
+	return response, nil
+}
+
+// queryServer sends an info query to the WhatsApp server and waits for the result
+// whatsmeow has no API for the business catalog, so the query is built from its low level request methods
+func (wc *WhatsmeowClient) queryServer(ctx context.Context, namespace string, attrs waBinary.Attrs, content waBinary.Node) (*waBinary.Node, error) {
+	internals := wc.client.DangerousInternals()
+
+	id := internals.GenerateRequestID()
+	iqAttrs := waBinary.Attrs{
+		"id":    id,
+		"xmlns": namespace,
+		"type":  "get",
+		"to":    waTypes.ServerJID,
+	}
+	for key, value := range attrs {
+		iqAttrs[key] = value
+	}
+
+	waiter := internals.WaitResponse(id)
+	if err := internals.SendNode(waBinary.Node{Tag: "iq", Attrs: iqAttrs, Content: []waBinary.Node{content}}); err != nil {
+		internals.CancelResponse(id, waiter)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, businessQueryTimeout)
+	defer cancel()
+
+	select {
+	case resp := <-waiter:
+		// Stream errors are delivered to waiters when the connection drops
+		if resp.Tag != "iq" {
+			return nil, fmt.Errorf("connection closed while waiting for response")
+		}
+		if resp.AttrGetter().OptionalString("type") == "error" {
+			return nil, iqError(resp)
+		}
+		return resp, nil
+	case <-ctx.Done():
+		internals.CancelResponse(id, waiter)
+		return nil, ctx.Err()
+	}
+}
+
+// iqError converts an error response to a whatsmeow IQError, so it can be matched with errors.Is
+func iqError(resp *waBinary.Node) error {
+	err := &whatsmeow.IQError{RawNode: resp}
+	if errNode, ok := resp.GetOptionalChildByTag("error"); ok {
+		err.ErrorNode = &errNode
+		err.Code = errNode.AttrGetter().OptionalInt("code")
+		err.Text = errNode.AttrGetter().OptionalString("text")
+	}
+	return err
+}
+
+// parseProducts reads the product children of a catalog or collection node
+func parseProducts(node waBinary.Node) []types.BusinessProduct {
+	products := []types.BusinessProduct{}
+	for _, product := range node.GetChildrenByTag("product") {
+		media := product.GetChildByTag("media")
+		image := media.GetChildByTag("image")
+		item := types.BusinessProduct{
+			ID:               nodeText(product, "id"),
+			Name:             nodeText(product, "name"),
+			Description:      nodeText(product, "description"),
+			Currency:         nodeText(product, "currency"),
+			RetailerID:       nodeText(product, "retailer_id"),
+			URL:              nodeText(product, "url"),
+			ImageURL:         nodeText(image, "request_image_url"),
+			OriginalImageURL: nodeText(image, "original_image_url"),
+			ReviewStatus:     nodeText(product.GetChildByTag("status_info"), "status"),
+			IsHidden:         product.AttrGetter().OptionalString("is_hidden") == "true",
+		}
+		// Prices are sent in thousandths of the currency unit
+		if price, err := strconv.ParseInt(nodeText(product, "price"), 10, 64); err == nil {
+			item.Price = float64(price) / 1000
+		}
+		products = append(products, item)
+	}
+	return products
+}
+
+// nodeText returns the text content of the child of a node with the given tag
+func nodeText(node waBinary.Node, tag string) string {
+	child, ok := node.GetOptionalChildByTag(tag)
+	if !ok {
+		return ""
+	}
+	text, _ := child.Content.([]byte)
+	return string(text)
+}
+
+// parseBusinessJID parses the JID or phone number of a business account
+func parseBusinessJID(jid string) (waTypes.JID, error) {
+	jids, err := parseParticipantJIDs([]string{jid})
+	if err != nil {
+		return waTypes.JID{}, err
+	}
+	return jids[0], nil
+}
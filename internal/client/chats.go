@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetAllChats lists chats from the database, optionally of one type or with one label, sorted by recent activity, unread count or name
+// Groups whose name is not known yet are named from the groups we are in
+func (wc *WhatsmeowClient) GetAllChats(chatType, labelID, sortBy string, count, offset int) (*types.ChatsResponse, error) {
+	response := &types.ChatsResponse{
+		Chats:   []types.Chat{},
+		Success: true,
+		Offset:  offset,
+	}
+	if wc.ourJID == "" {
+		return response, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	chats, total, err := wc.messageStore.GetChats(ctx, wc.ourJID, chatType, labelID, sortBy, count, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chats: %w", err)
+	}
+	wc.fillGroupNames(chats)
+
+	response.Chats = chats
+	response.Count = len(chats)
+	response.Total = total
+	response.HasMore = offset+len(chats) < total
+
+	return response, nil
+}
+
+// ArchiveChat archives or unarchives a chat on all our devices
+// Archiving also unpins the chat, like on the phone
+func (wc *WhatsmeowClient) ArchiveChat(ctx context.Context, jid string, archive bool) (*types.ChatSettingsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	info := database.ChatInfo{JID: chat.String(), Archived: &archive}
+	if archive {
+		pinned := false
+		info.Pinned = &pinned
+	}
+
+	patch := appstate.BuildArchive(chat, archive, wc.lastMessageTime(ctx, chat), nil)
+	return wc.updateChatSettings(ctx, chat, patch, info)
+}
+
+// PinChat pins or unpins a chat on all our devices
+func (wc *WhatsmeowClient) PinChat(ctx context.Context, jid string, pin bool) (*types.ChatSettingsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := appstate.BuildPin(chat, pin)
+	return wc.updateChatSettings(ctx, chat, patch, database.ChatInfo{JID: chat.String(), Pinned: &pin})
+}
+
+// MuteChat mutes a chat for the given duration, or forever if it is zero, or unmutes it
+func (wc *WhatsmeowClient) MuteChat(ctx context.Context, jid string, mute bool, duration time.Duration) (*types.ChatSettingsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !mute {
+		duration = 0
+	}
+
+	var mutedUntil int64
+	switch {
+	case mute && duration > 0:
+		mutedUntil = time.Now().Add(duration).Unix()
+	case mute:
+		mutedUntil = -1
+	}
+
+	patch := appstate.BuildMute(chat, mute, duration)
+	return wc.updateChatSettings(ctx, chat, patch, database.ChatInfo{JID: chat.String(), MutedUntil: &mutedUntil})
+}
+
+// MarkChatUnread marks a chat as unread, or as read, on all our devices
+// Marking a chat as read also marks its stored messages as read
+func (wc *WhatsmeowClient) MarkChatUnread(ctx context.Context, jid string, unread bool) (*types.ChatSettingsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	// whatsmeow has no builder for this patch
+	patch := appstate.PatchInfo{
+		Type: appstate.WAPatchRegularLow,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexMarkChatAsRead, chat.String()},
+			Version: 3,
+			Value: &waSyncAction.SyncActionValue{
+				MarkChatAsReadAction: &waSyncAction.MarkChatAsReadAction{
+					Read: proto.Bool(!unread),
+					MessageRange: &waSyncAction.SyncActionMessageRange{
+						LastMessageTimestamp: proto.Int64(wc.lastMessageTime(ctx, chat).Unix()),
+					},
+				},
+			},
+		}},
+	}
+
+	if err := wc.client.SendAppState(ctx, patch); err != nil {
+		return nil, fmt.Errorf("failed to update chat settings: %w", err)
+	}
+
+	if unread {
+		wc.saveChatInfo(database.ChatInfo{JID: chat.String(), MarkedUnread: &unread})
+	} else {
+		wc.markChatRead(chat.String())
+	}
+
+	return wc.chatSettingsResponse(ctx, chat), nil
+}
+
+// updateChatSettings sends an app state patch changing the settings of a chat and stores the new settings
+// The patch is also echoed back as an app state event, storing it here makes the response up to date
+func (wc *WhatsmeowClient) updateChatSettings(ctx context.Context, chat waTypes.JID, patch appstate.PatchInfo, info database.ChatInfo) (*types.ChatSettingsResponse, error) {
+	if err := wc.client.SendAppState(ctx, patch); err != nil {
+		return nil, fmt.Errorf("failed to update chat settings: %w", err)
+	}
+
+	wc.saveChatInfo(info)
+
+	return wc.chatSettingsResponse(ctx, chat), nil
+}
+
+// chatSettingsResponse builds the response of a chat settings change from the stored chat
+func (wc *WhatsmeowClient) chatSettingsResponse(ctx context.Context, chat waTypes.JID) *types.ChatSettingsResponse {
+	response := &types.ChatSettingsResponse{
+		Success: true,
+		JID:     chat.String(),
+	}
+
+	stored, err := wc.messageStore.GetChat(ctx, wc.ourJID, chat.String())
+	if err != nil {
+		log.Printf("Failed to get chat %s: %v", chat, err)
+	}
+	response.Chat = stored
+
+	return response
+}
+
+// lastMessageTime returns the time of the last stored message of a chat, or now if none is stored
+// App state patches of a chat refer to its last message so other devices apply them to the same messages
+func (wc *WhatsmeowClient) lastMessageTime(ctx context.Context, chat waTypes.JID) time.Time {
+	stored, err := wc.messageStore.GetChat(ctx, wc.ourJID, chat.String())
+	if err != nil || stored == nil || stored.LastMessageAt == 0 {
+		return time.Now()
+	}
+	return time.Unix(stored.LastMessageAt, 0)
+}
+
+// handleArchive stores a chat being archived or unarchived on another device
+func (wc *WhatsmeowClient) handleArchive(evt *events.Archive) {
+	archived := evt.Action.GetArchived()
+	wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), Archived: &archived})
+}
+
+// handlePin stores a chat being pinned or unpinned on another device
+func (wc *WhatsmeowClient) handlePin(evt *events.Pin) {
+	pinned := evt.Action.GetPinned()
+	wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), Pinned: &pinned})
+}
+
+// handleMute stores a chat being muted or unmuted on another device
+func (wc *WhatsmeowClient) handleMute(evt *events.Mute) {
+	var mutedUntil int64
+	if evt.Action.GetMuted() {
+		// Chats muted forever have no end time
+		mutedUntil = muteEndTime(evt.Action.GetMuteEndTimestamp())
+		if mutedUntil == 0 {
+			mutedUntil = -1
+		}
+	}
+	wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), MutedUntil: &mutedUntil})
+}
+
+// handleMarkChatAsRead stores a chat being marked as read or unread on another device
+func (wc *WhatsmeowClient) handleMarkChatAsRead(evt *events.MarkChatAsRead) {
+	if evt.Action.GetRead() {
+		wc.markChatRead(evt.JID.String())
+		return
+	}
+
+	markedUnread := true
+	wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), MarkedUnread: &markedUnread})
+}
+
+// markChatRead marks the stored messages of a chat as read and clears its marked unread flag, logging failures
+func (wc *WhatsmeowClient) markChatRead(chatJID string) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.MarkMessagesAsRead(ctx, wc.ourJID, chatJID); err != nil {
+		log.Printf("Failed to mark chat %s as read: %v", chatJID, err)
+	}
+}
+
+// fillGroupNames sets missing group names from the list of joined groups and stores them for next time
+func (wc *WhatsmeowClient) fillGroupNames(chats []types.Chat) {
+	missing := false
+	for _, chat := range chats {
+		if chat.Type == types.ChatTypeGroup && chat.Name == "" {
+			missing = true
+			break
+		}
+	}
+	if !missing || !wc.IsLoggedIn() {
+		return
+	}
+
+	groups, err := wc.client.GetJoinedGroups()
+	if err != nil {
+		log.Printf("Failed to get joined groups for chat names: %v", err)
+		return
+	}
+
+	names := make(map[string]string, len(groups))
+	for _, group := range groups {
+		if group.Name != "" {
+			names[group.JID.String()] = group.Name
+			wc.saveChatInfo(database.ChatInfo{JID: group.JID.String(), Name: group.Name})
+		}
+	}
+	for i, chat := range chats {
+		if chat.Type == types.ChatTypeGroup && chat.Name == "" {
+			chats[i].Name = names[chat.JID]
+		}
+	}
+}
+
+// saveHistoryChat stores the name and settings of a conversation delivered with a history sync
+func (wc *WhatsmeowClient) saveHistoryChat(conversation *waHistorySync.Conversation) {
+	archived := conversation.GetArchived()
+	pinned := conversation.GetPinned() > 0
+	markedUnread := conversation.GetMarkedAsUnread()
+	mutedUntil := muteEndTime(int64(conversation.GetMuteEndTime()))
+
+	name := conversation.GetName()
+	if name == "" {
+		name = conversation.GetDisplayName()
+	}
+
+	wc.saveChatInfo(database.ChatInfo{
+		JID:          conversation.GetID(),
+		Name:         name,
+		Archived:     &archived,
+		Pinned:       &pinned,
+		MutedUntil:   &mutedUntil,
+		MarkedUnread: &markedUnread,
+	})
+}
+
+// saveChatInfo stores the name and settings of a chat, logging failures
+func (wc *WhatsmeowClient) saveChatInfo(info database.ChatInfo) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SaveChatInfo(ctx, info, wc.ourJID); err != nil {
+		log.Printf("Failed to save chat info of %s: %v", info.JID, err)
+	}
+}
+
+// refreshChat updates the last message and unread count of a chat, logging failures
+func (wc *WhatsmeowClient) refreshChat(chatJID string) {
+	if wc.ourJID == "" || chatJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.RefreshChat(ctx, wc.ourJID, chatJID); err != nil {
+		log.Printf("Failed to refresh chat %s: %v", chatJID, err)
+	}
+}
+
+// clearHistoryUnreadCount clears the unread count a chat got from history sync, logging failures
+func (wc *WhatsmeowClient) clearHistoryUnreadCount(chatJID string) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.ClearHistoryUnreadCount(ctx, wc.ourJID, chatJID); err != nil {
+		log.Printf("Failed to clear history unread count of %s: %v", chatJID, err)
+	}
+}
+
+// muteEndTime converts a mute end time from WhatsApp, in seconds or milliseconds, to Unix seconds
+// Negative values mean muted forever and are stored as -1
+func muteEndTime(timestamp int64) int64 {
+	switch {
+	case timestamp < 0:
+		return -1
+	case timestamp > 1e11:
+		return timestamp / 1000
+	}
+	return timestamp
+}
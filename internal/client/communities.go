@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// ErrNotCommunity is returned when a community tool is used with a regular group
+var ErrNotCommunity = errors.New("group is not a community")
+
+// CreateCommunity creates a community with an optional description
+// WhatsApp creates the announcement group of the community along with it
+// Automatically subscribes the caller's MCP session to the announcement group
+func (wc *WhatsmeowClient) CreateCommunity(ctx context.Context, name, description string, joinApproval bool) (*types.CreateCommunityResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("community name is required")
+	}
+
+	req := whatsmeow.ReqCreateGroup{Name: name}
+	req.IsParent = true
+	if joinApproval {
+		req.DefaultMembershipApprovalMode = "request_required"
+	}
+
+	info, err := wc.client.CreateGroup(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create community: %w", err)
+	}
+
+	communityJID := info.JID.String()
+	log.Printf("Created community %s (%s)", communityJID, name)
+
+	response := &types.CreateCommunityResponse{
+		Success:              true,
+		CommunityJID:         communityJID,
+		Name:                 info.Name,
+		CreatedAt:            info.GroupCreated.Unix(),
+		JoinApprovalRequired: joinApproval,
+	}
+
+	// The description can only be set once the community exists
+	if description != "" {
+		if err := wc.client.SetGroupTopic(info.JID, "", "", description); err != nil {
+			log.Printf("Failed to set description of new community %s: %v", communityJID, err)
+		} else {
+			response.Description = description
+		}
+	}
+
+	// Auto-subscribe session to the announcement group, where community messages are posted
+	if announcement, err := wc.announcementGroup(info.JID); err != nil {
+		log.Printf("Failed to get announcement group of new community %s: %v", communityJID, err)
+	} else {
+		response.AnnouncementGroupJID = announcement.JID.String()
+		wc.autoSubscribe(ctx, response.AnnouncementGroupJID)
+	}
+
+	return response, nil
+}
+
+// GetCommunitySubGroups lists the groups linked to a community, including its announcement group
+func (wc *WhatsmeowClient) GetCommunitySubGroups(ctx context.Context, communityJID string) (*types.CommunitySubGroupsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := wc.parseCommunityJID(communityJID)
+	if err != nil {
+		return nil, err
+	}
+
+	subGroups, err := wc.client.GetSubGroups(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community sub-groups: %w", err)
+	}
+
+	response := &types.CommunitySubGroupsResponse{
+		Success:      true,
+		CommunityJID: communityJID,
+		Count:        len(subGroups),
+		SubGroups:    make([]types.CommunitySubGroup, 0, len(subGroups)),
+	}
+	for _, group := range subGroups {
+		if group.IsDefaultSubGroup {
+			response.AnnouncementGroupJID = group.JID.String()
+		}
+		response.SubGroups = append(response.SubGroups, types.CommunitySubGroup{
+			GroupJID:       group.JID.String(),
+			Name:           group.Name,
+			IsAnnouncement: group.IsDefaultSubGroup,
+		})
+	}
+
+	return response, nil
+}
+
+// UpdateCommunityGroups links existing groups to a community and unlinks groups from it
+// A failing change is reported per group and does not stop the remaining changes
+func (wc *WhatsmeowClient) UpdateCommunityGroups(ctx context.Context, communityJID string, link, unlink []string) (*types.UpdateCommunityGroupsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := wc.parseCommunityJID(communityJID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []struct {
+		action string
+		groups []string
+		apply  func(parent, child waTypes.JID) error
+	}{
+		{"link", link, wc.client.LinkGroup},
+		{"unlink", unlink, wc.client.UnlinkGroup},
+	}
+
+	// Validate all groups before changing anything
+	groupJIDs := make([][]waTypes.JID, len(changes))
+	for i, change := range changes {
+		for _, group := range change.groups {
+			groupJID, err := parseGroupJID(group)
+			if err != nil {
+				return nil, err
+			}
+			groupJIDs[i] = append(groupJIDs[i], groupJID)
+		}
+	}
+
+	response := &types.UpdateCommunityGroupsResponse{
+		Success:      true,
+		CommunityJID: communityJID,
+		Results:      []types.CommunityGroupResult{},
+	}
+	for i, change := range changes {
+		for _, groupJID := range groupJIDs[i] {
+			result := types.CommunityGroupResult{
+				GroupJID: groupJID.String(),
+				Action:   change.action,
+				Success:  true,
+			}
+			if err := change.apply(jid, groupJID); err != nil {
+				log.Printf("Failed to %s group %s in community %s: %v", change.action, groupJID, communityJID, err)
+				result.Success = false
+				result.Error = communityGroupErrorReason(err)
+			}
+			response.Results = append(response.Results, result)
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+// SendCommunityAnnouncement posts a text message to the announcement group of a community
+// Automatically subscribes the caller's MCP session to the announcement group
+func (wc *WhatsmeowClient) SendCommunityAnnouncement(ctx context.Context, communityJID, text string) (*types.CommunityAnnouncementResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := wc.parseCommunityJID(communityJID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := wc.announcementGroup(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := wc.SendMessage(ctx, announcement.JID.String(), text, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CommunityAnnouncementResponse{
+		Success:              true,
+		MessageID:            sent.MessageID,
+		Timestamp:            sent.Timestamp,
+		CommunityJID:         communityJID,
+		AnnouncementGroupJID: sent.To,
+		Text:                 text,
+	}, nil
+}
+
+// parseCommunityJID parses a group JID and ensures the group is a community
+func (wc *WhatsmeowClient) parseCommunityJID(communityJID string) (waTypes.JID, error) {
+	jid, err := parseGroupJID(communityJID)
+	if err != nil {
+		return jid, err
+	}
+
+	info, err := wc.client.GetGroupInfo(jid)
+	if err != nil {
+		return jid, fmt.Errorf("failed to get community info: %w", err)
+	}
+	if !info.IsParent {
+		return jid, fmt.Errorf("%s: %w", communityJID, ErrNotCommunity)
+	}
+
+	return jid, nil
+}
+
+// announcementGroup returns the announcement group of a community
+func (wc *WhatsmeowClient) announcementGroup(community waTypes.JID) (*waTypes.GroupLinkTarget, error) {
+	subGroups, err := wc.client.GetSubGroups(community)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community sub-groups: %w", err)
+	}
+	for _, group := range subGroups {
+		if group.IsDefaultSubGroup {
+			return group, nil
+		}
+	}
+	return nil, fmt.Errorf("community %s has no announcement group", community)
+}
+
+// communityGroupErrorReason describes why linking or unlinking a group failed
+func communityGroupErrorReason(err error) string {
+	switch {
+	case errors.Is(err, whatsmeow.ErrIQForbidden), errors.Is(err, whatsmeow.ErrIQNotAuthorized):
+		return "you must be an admin of both the community and the group"
+	case errors.Is(err, whatsmeow.ErrIQNotFound):
+		return "group not found or not linked to this community"
+	}
+	return err.Error()
+}
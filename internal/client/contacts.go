@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"whatsmeow-mcp/internal/types"
+	"whatsmeow-mcp/internal/vcard"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// SendContactMessage sends one or more vCards as a contact message
+// A single card is sent as a ContactMessage, several cards as a ContactsArrayMessage
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendContactMessage(ctx context.Context, to string, vcards []string, quotedMessageID string) (*types.ContactMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	if len(vcards) == 0 {
+		return nil, fmt.Errorf("at least one contact is required")
+	}
+
+	// Validate cards and read display names
+	contactMsgs := make([]*waProto.ContactMessage, 0, len(vcards))
+	contacts := make([]types.ContactCard, 0, len(vcards))
+	for i, raw := range vcards {
+		card, err := vcard.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vCard for contact %d: %w", i+1, err)
+		}
+		if card.Name == "" {
+			return nil, fmt.Errorf("vCard for contact %d has no name", i+1)
+		}
+
+		contactMsgs = append(contactMsgs, &waProto.ContactMessage{
+			DisplayName: proto.String(card.Name),
+			Vcard:       proto.String(raw),
+		})
+		contacts = append(contacts, parseContactCard(raw, card.Name))
+	}
+
+	var msg *waProto.Message
+	if len(contactMsgs) == 1 {
+		contactMsgs[0].ContextInfo = buildQuoteContext(quotedMessageID)
+		msg = &waProto.Message{ContactMessage: contactMsgs[0]}
+	} else {
+		msg = &waProto.Message{
+			ContactsArrayMessage: &waProto.ContactsArrayMessage{
+				DisplayName: proto.String(fmt.Sprintf("%d contacts", len(contactMsgs))),
+				Contacts:    contactMsgs,
+				ContextInfo: buildQuoteContext(quotedMessageID),
+			},
+		}
+	}
+
+	resp, err := wc.sendAndSave(ctx, jid, to, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send contact message: %w", err)
+	}
+
+	log.Printf("Sent contact message %s with %d contact(s) to %s", resp.ID, len(contacts), to)
+
+	return &types.ContactMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		To:              to,
+		Contacts:        contacts,
+		QuotedMessageID: quotedMessageID,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// GetContacts returns known contacts from database, optionally filtered by a name or phone number search
+func (wc *WhatsmeowClient) GetContacts(query string, count int) []types.Contact {
+	if wc.ourJID == "" {
+		return []types.Contact{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	contacts, err := wc.messageStore.GetContacts(ctx, wc.ourJID, query, count)
+	if err != nil {
+		log.Printf("Failed to get contacts from database: %v", err)
+		return []types.Contact{}
+	}
+
+	return contacts
+}
+
+// syncContacts copies all contacts from the whatsmeow contact store, which is filled by app state sync
+func (wc *WhatsmeowClient) syncContacts() {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	contacts, err := wc.client.Store.Contacts.GetAllContacts(ctx)
+	if err != nil {
+		log.Printf("Failed to load contacts: %v", err)
+		return
+	}
+
+	saved := 0
+	for jid, info := range contacts {
+		contact := database.Contact{
+			JID:          jid.String(),
+			FirstName:    info.FirstName,
+			FullName:     info.FullName,
+			PushName:     info.PushName,
+			BusinessName: info.BusinessName,
+		}
+		if err := wc.messageStore.SaveContact(ctx, contact, wc.ourJID); err != nil {
+			log.Printf("Failed to save contact %s: %v", jid, err)
+			continue
+		}
+		saved++
+	}
+
+	log.Printf("Synced %d contacts", saved)
+}
+
+// handleContact stores the address book name of a contact changed on the phone
+func (wc *WhatsmeowClient) handleContact(evt *events.Contact) {
+	contact := database.Contact{
+		JID:       evt.JID.String(),
+		FirstName: evt.Action.GetFirstName(),
+		FullName:  evt.Action.GetFullName(),
+	}
+	wc.saveContact(contact)
+
+	// Group messages may be sent from the LID of the contact
+	if lid := evt.Action.GetLidJID(); lid != "" {
+		contact.JID = lid
+		wc.saveContact(contact)
+	}
+}
+
+// handleBusinessName stores the verified name of a business account
+func (wc *WhatsmeowClient) handleBusinessName(evt *events.BusinessName) {
+	wc.saveContact(database.Contact{
+		JID:          evt.JID.String(),
+		BusinessName: evt.NewBusinessName,
+	})
+}
+
+// savePushName stores the push name a user sent with a message, under both of their addresses if known
+func (wc *WhatsmeowClient) savePushName(sender, senderAlt waTypes.JID, pushName string) {
+	wc.saveContact(database.Contact{JID: sender.ToNonAD().String(), PushName: pushName})
+	if !senderAlt.IsEmpty() {
+		wc.saveContact(database.Contact{JID: senderAlt.ToNonAD().String(), PushName: pushName})
+	}
+}
+
+// saveHistoryPushNames stores the push names delivered with a history sync
+func (wc *WhatsmeowClient) saveHistoryPushNames(pushNames []*waHistorySync.Pushname) {
+	for _, pushName := range pushNames {
+		if pushName.GetID() == "" || pushName.GetPushname() == "" {
+			continue
+		}
+		wc.saveContact(database.Contact{JID: pushName.GetID(), PushName: pushName.GetPushname()})
+	}
+}
+
+// saveContact stores the names of a contact, logging failures
+func (wc *WhatsmeowClient) saveContact(contact database.Contact) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SaveContact(ctx, contact, wc.ourJID); err != nil {
+		log.Printf("Failed to save contact %s: %v", contact.JID, err)
+	}
+}
+
+// contactName returns the best known name of a user, or an empty string if there is none
+func (wc *WhatsmeowClient) contactName(jid string) string {
+	if wc.ourJID == "" {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	name, err := wc.messageStore.GetContactName(ctx, wc.ourJID, jid)
+	if err != nil {
+		log.Printf("Failed to get contact name of %s: %v", jid, err)
+	}
+	return name
+}
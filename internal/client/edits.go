@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// EditMessage replaces the text of one of our own text messages
+// WhatsApp only accepts edits within whatsmeow.EditWindow after the message was sent
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) EditMessage(ctx context.Context, chatJID, messageID, text string) (*types.EditMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse chat JID
+	chat, err := waTypes.ParseJID(chatJID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat JID: %w", err)
+	}
+
+	// The original message is needed to check ownership and the edit window
+	original, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+	if errors.Is(err, database.ErrMessageNotFound) {
+		return nil, fmt.Errorf("message %s not found in history", messageID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if original.Chat != chatJID {
+		return nil, fmt.Errorf("message %s does not belong to chat %s", messageID, chatJID)
+	}
+	if !wc.isOwnSender(original.From) {
+		return nil, fmt.Errorf("only messages sent by us can be edited")
+	}
+	if original.MessageType != types.MessageTypeText {
+		return nil, fmt.Errorf("only text messages can be edited, message is of type %s", original.MessageType)
+	}
+	if sentAt := time.Unix(original.Timestamp, 0); time.Since(sentAt) > whatsmeow.EditWindow {
+		return nil, fmt.Errorf("message was sent %s ago, edits are only allowed within %s", time.Since(sentAt).Round(time.Minute), whatsmeow.EditWindow)
+	}
+
+	msg := wc.client.BuildEdit(chat, messageID, &waProto.Message{
+		Conversation: proto.String(text),
+	})
+	resp, err := wc.client.SendMessage(context.Background(), chat, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send edit: %w", err)
+	}
+
+	// Auto-subscribe session to this chat
+	wc.autoSubscribe(ctx, chatJID)
+
+	wc.applyEdit(messageEdit{
+		MessageID: messageID,
+		Sender:    "self",
+		Text:      text,
+		Timestamp: resp.Timestamp.Unix(),
+	})
+
+	return &types.EditMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		Chat:            chatJID,
+		TargetMessageID: messageID,
+		Text:            text,
+		PreviousText:    original.Text,
+	}, nil
+}
+
+// isOwnSender reports whether a stored sender refers to our own account
+func (wc *WhatsmeowClient) isOwnSender(senderJID string) bool {
+	if senderJID == "self" {
+		return true
+	}
+	sender, err := waTypes.ParseJID(senderJID)
+	if err != nil || wc.client.Store.ID == nil {
+		return false
+	}
+	return sender.User == wc.client.Store.ID.User
+}
+
+// applyEdit updates the stored text of an edited message, keeping the previous text as a revision
+// Edits from anyone but the author, or after whatsmeow.EditWindow, are not applied
+func (wc *WhatsmeowClient) applyEdit(edit messageEdit) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := wc.messageStore.EditMessage(ctx, wc.ourJID, edit.MessageID, edit.Sender, edit.Text, edit.Timestamp, whatsmeow.EditWindow); err != nil {
+		log.Printf("Failed to apply edit of message %s: %v", edit.MessageID, err)
+		return
+	}
+
+	log.Printf("Message %s edited: %s", edit.MessageID, edit.Text)
+}
@@ -0,0 +1,327 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// groupEventMessage is a group change rendered as a system message
+type groupEventMessage struct {
+	event types.GroupEvent
+	text  string
+
+	// version identifies the change instead of its timestamp for events that carry none
+	version string
+}
+
+// handleGroupInfo stores the changes of a group as system messages and forwards new join requests
+func (wc *WhatsmeowClient) handleGroupInfo(evt *events.GroupInfo) {
+	wc.handleJoinRequests(evt)
+
+	actor := ""
+	if evt.Sender != nil {
+		actor = wc.eventUser(*evt.Sender, evt.SenderPN)
+	}
+
+	var changes []groupEventMessage
+	add := func(action string, participants []string, value, text string) {
+		changes = append(changes, groupEventMessage{
+			event: types.GroupEvent{Action: action, Actor: actor, Participants: participants, Value: value},
+			text:  text,
+		})
+	}
+	who := wc.actorName(actor)
+
+	if evt.Name != nil {
+		wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), Name: evt.Name.Name})
+		add(types.GroupEventName, nil, evt.Name.Name, fmt.Sprintf("%s changed the group name to %q", who, evt.Name.Name))
+	}
+	if evt.Topic != nil {
+		if evt.Topic.TopicDeleted {
+			add(types.GroupEventDescription, nil, "", fmt.Sprintf("%s removed the group description", who))
+		} else {
+			add(types.GroupEventDescription, nil, evt.Topic.Topic, fmt.Sprintf("%s changed the group description", who))
+		}
+	}
+
+	if len(evt.Join) > 0 {
+		joined := wc.eventUsers(evt.Join)
+		if evt.JoinReason == "invite" || actor == "" || (len(joined) == 1 && joined[0] == actor) {
+			text := fmt.Sprintf("%s joined", wc.listNames(joined))
+			if evt.JoinReason == "invite" {
+				text += " using an invite link"
+			}
+			add(types.GroupEventJoin, joined, "", text)
+		} else {
+			add(types.GroupEventAdd, joined, "", fmt.Sprintf("%s added %s", who, wc.listNames(joined)))
+		}
+	}
+	if len(evt.Leave) > 0 {
+		left := wc.eventUsers(evt.Leave)
+		if actor == "" || (len(left) == 1 && left[0] == actor) {
+			add(types.GroupEventLeave, left, "", fmt.Sprintf("%s left", wc.listNames(left)))
+		} else {
+			add(types.GroupEventRemove, left, "", fmt.Sprintf("%s removed %s", who, wc.listNames(left)))
+		}
+	}
+	if len(evt.Promote) > 0 {
+		promoted := wc.eventUsers(evt.Promote)
+		add(types.GroupEventPromote, promoted, "", fmt.Sprintf("%s made %s admin", who, wc.listNames(promoted)))
+	}
+	if len(evt.Demote) > 0 {
+		demoted := wc.eventUsers(evt.Demote)
+		add(types.GroupEventDemote, demoted, "", fmt.Sprintf("%s dismissed %s as admin", who, wc.listNames(demoted)))
+	}
+
+	if evt.Locked != nil {
+		if evt.Locked.IsLocked {
+			add(types.GroupEventLocked, nil, "on", fmt.Sprintf("%s changed the group settings so only admins can edit group info", who))
+		} else {
+			add(types.GroupEventLocked, nil, "off", fmt.Sprintf("%s changed the group settings so all participants can edit group info", who))
+		}
+	}
+	if evt.Announce != nil {
+		if evt.Announce.IsAnnounce {
+			add(types.GroupEventAnnounce, nil, "on", fmt.Sprintf("%s changed the group settings so only admins can send messages", who))
+		} else {
+			add(types.GroupEventAnnounce, nil, "off", fmt.Sprintf("%s changed the group settings so all participants can send messages", who))
+		}
+	}
+	if evt.Ephemeral != nil {
+		if evt.Ephemeral.IsEphemeral {
+			timer := time.Duration(evt.Ephemeral.DisappearingTimer) * time.Second
+			add(types.GroupEventEphemeral, nil, fmt.Sprint(evt.Ephemeral.DisappearingTimer), fmt.Sprintf("%s turned on disappearing messages (%s)", who, formatTimer(timer)))
+		} else {
+			add(types.GroupEventEphemeral, nil, "0", fmt.Sprintf("%s turned off disappearing messages", who))
+		}
+	}
+	if evt.MembershipApprovalMode != nil {
+		if evt.MembershipApprovalMode.IsJoinApprovalRequired {
+			add(types.GroupEventJoinApproval, nil, "on", fmt.Sprintf("%s turned on admin approval to join this group", who))
+		} else {
+			add(types.GroupEventJoinApproval, nil, "off", fmt.Sprintf("%s turned off admin approval to join this group", who))
+		}
+	}
+	if evt.NewInviteLink != nil {
+		add(types.GroupEventInviteLinkReset, nil, "", fmt.Sprintf("%s reset the group invite link", who))
+	}
+	if evt.Link != nil {
+		add(types.GroupEventLinkGroup, []string{evt.Link.Group.JID.String()}, evt.Link.Group.Name, fmt.Sprintf("%s added the group %q to the community", who, evt.Link.Group.Name))
+	}
+	if evt.Unlink != nil {
+		add(types.GroupEventUnlinkGroup, []string{evt.Unlink.Group.JID.String()}, evt.Unlink.Group.Name, fmt.Sprintf("%s removed the group %q from the community", who, evt.Unlink.Group.Name))
+	}
+	if evt.Delete != nil {
+		add(types.GroupEventDelete, nil, evt.Delete.DeleteReason, fmt.Sprintf("%s deleted the group", who))
+	}
+
+	for _, change := range changes {
+		wc.storeGroupEvent(evt.JID, evt.Timestamp, change)
+	}
+}
+
+// handleJoinedGroup stores a system message when we create, are added to or join a group
+func (wc *WhatsmeowClient) handleJoinedGroup(evt *events.JoinedGroup) {
+	actor := ""
+	if evt.Sender != nil {
+		actor = wc.eventUser(*evt.Sender, evt.SenderPN)
+	}
+
+	wc.saveChatInfo(database.ChatInfo{JID: evt.JID.String(), Name: evt.Name})
+
+	// The event has no timestamp of its own. Created groups use their creation time, other events are
+	// shown at the time they arrive and identified by the participant list version they produced,
+	// so a redelivered event keeps its message ID
+	change := groupEventMessage{event: types.GroupEvent{Actor: actor}}
+	timestamp := evt.GroupCreated
+	if evt.Type != "new" || timestamp.IsZero() {
+		timestamp = time.Now()
+		change.version = evt.ParticipantVersionID
+		if change.version == "" {
+			change.version = fmt.Sprint(evt.GroupCreated.Unix())
+		}
+	}
+
+	switch {
+	case evt.Type == "new":
+		change.event.Action = types.GroupEventCreate
+		change.event.Value = evt.Name
+		change.text = fmt.Sprintf("%s created the group %q", wc.userName(actor, evt.Participants), evt.Name)
+	case evt.Reason == "invite":
+		change.event.Action = types.GroupEventJoin
+		change.event.Participants = []string{"self"}
+		change.text = "You joined using an invite link"
+	case actor != "":
+		change.event.Action = types.GroupEventAdd
+		change.event.Participants = []string{"self"}
+		change.text = fmt.Sprintf("%s added you", wc.userName(actor, evt.Participants))
+	default:
+		change.event.Action = types.GroupEventAdd
+		change.event.Participants = []string{"self"}
+		change.text = "You were added to the group"
+	}
+
+	wc.storeGroupEvent(evt.JID, timestamp, change)
+}
+
+// storeGroupEvent saves a group change as a system message in the group's chat and notifies subscribed sessions
+func (wc *WhatsmeowClient) storeGroupEvent(groupJID waTypes.JID, timestamp time.Time, change groupEventMessage) {
+	event := change.event
+	message := types.Message{
+		ID:          groupEventID(groupJID, timestamp, change.version, event),
+		From:        event.Actor,
+		Chat:        groupJID.String(),
+		Text:        change.text,
+		Timestamp:   timestamp.Unix(),
+		MessageType: types.MessageTypeGroupEvent,
+		GroupEvent:  &event,
+	}
+	// System messages without a known actor are attributed to the group itself
+	if message.From == "" {
+		message.From = message.Chat
+	}
+
+	log.Printf("Group %s: %s", message.Chat, message.Text)
+
+	if wc.ourJID != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := wc.storeMessage(ctx, message, nil); err != nil {
+			log.Printf("Failed to save group event: %v", err)
+		}
+		wc.refreshChat(message.Chat)
+	}
+
+	// Send MCP notification to subscribed sessions
+	if wc.subscriptionManager != nil {
+		wc.subscriptionManager.NotifyGroupEvent(message.Chat, message.ID, event, message.Text, message.Timestamp)
+	}
+}
+
+// groupEventID derives a stable message ID for a group change, so redelivered events do not create duplicates
+// The change is identified by its version if given, otherwise by its timestamp
+func groupEventID(groupJID waTypes.JID, timestamp time.Time, version string, event types.GroupEvent) string {
+	if version == "" {
+		version = fmt.Sprint(timestamp.Unix())
+	}
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		groupJID.String(),
+		version,
+		event.Action,
+		event.Actor,
+		strings.Join(event.Participants, ","),
+		event.Value,
+	}, "|")))
+	return "GE" + strings.ToUpper(hex.EncodeToString(hash[:9]))
+}
+
+// eventUser returns the JID used for a user in group events: self for our account, otherwise the phone number JID if known
+// Users addressed by LID are resolved from the LID mappings when no phone number is given,
+// so actors and participants of the same change are comparable
+func (wc *WhatsmeowClient) eventUser(jid waTypes.JID, phoneNumber *waTypes.JID) string {
+	if phoneNumber != nil && !phoneNumber.IsEmpty() {
+		jid = *phoneNumber
+	} else if jid.Server == waTypes.HiddenUserServer {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if pn, err := wc.client.Store.LIDs.GetPNForLID(ctx, jid.ToNonAD()); err == nil && !pn.IsEmpty() {
+			jid = pn
+		}
+		cancel()
+	}
+	jid = jid.ToNonAD()
+	if wc.isOwnSender(jid.String()) || (wc.client.Store.LID.User != "" && jid.User == wc.client.Store.LID.User) {
+		return "self"
+	}
+	return jid.String()
+}
+
+// eventUsers converts the participants of a group change with eventUser
+func (wc *WhatsmeowClient) eventUsers(jids []waTypes.JID) []string {
+	users := make([]string, 0, len(jids))
+	for _, jid := range jids {
+		users = append(users, wc.eventUser(jid, nil))
+	}
+	return users
+}
+
+// actorName returns how the user who made a change is shown in system message texts
+// Users are named from our contacts or their push name, otherwise by their phone number
+func (wc *WhatsmeowClient) actorName(user string) string {
+	return wc.userName(user, nil)
+}
+
+// userName names a user for system message texts like actorName
+// Anonymous participants of announcement groups are named by their display name in participants
+func (wc *WhatsmeowClient) userName(user string, participants []waTypes.GroupParticipant) string {
+	switch user {
+	case "":
+		return "Someone"
+	case "self":
+		return "You"
+	}
+
+	if name := wc.contactName(user); name != "" {
+		return name
+	}
+
+	jid, err := waTypes.ParseJID(user)
+	if err != nil {
+		return user
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if contact, err := wc.client.Store.Contacts.GetContact(ctx, jid); err == nil && contact.Found {
+		for _, name := range []string{contact.FullName, contact.FirstName, contact.BusinessName, contact.PushName} {
+			if name != "" {
+				return name
+			}
+		}
+	}
+
+	for _, participant := range participants {
+		if participant.DisplayName != "" && (participant.JID.User == jid.User || participant.PhoneNumber.User == jid.User) {
+			return participant.DisplayName
+		}
+	}
+
+	return jid.User
+}
+
+// listNames joins the names of several users for system message texts
+func (wc *WhatsmeowClient) listNames(users []string) string {
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		if user == "self" {
+			names = append(names, "you")
+			continue
+		}
+		names = append(names, wc.actorName(user))
+	}
+	if len(names) <= 1 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// formatTimer formats a disappearing messages timer as days or hours
+func formatTimer(timer time.Duration) string {
+	if days := int(timer.Hours() / 24); days > 0 && timer%(24*time.Hour) == 0 {
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	}
+	return timer.String()
+}
@@ -0,0 +1,428 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+var (
+	// ErrGroupNotFound is returned when a group does not exist
+	ErrGroupNotFound = whatsmeow.ErrGroupNotFound
+	// ErrNotInGroup is returned when we are not a participant of a group
+	ErrNotInGroup = whatsmeow.ErrNotInGroup
+)
+
+// CreateGroup creates a group with the given participants and optional description
+// Participants that cannot be added are reported with a reason instead of failing the whole request
+// Automatically subscribes the caller's MCP session to messages from the new group
+func (wc *WhatsmeowClient) CreateGroup(ctx context.Context, name string, participants []string, description string) (*types.CreateGroupResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("group name is required")
+	}
+
+	participantJIDs, err := parseParticipantJIDs(participants)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := wc.client.CreateGroup(ctx, whatsmeow.ReqCreateGroup{
+		Name:         name,
+		Participants: participantJIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	groupJID := info.JID.String()
+	log.Printf("Created group %s (%s)", groupJID, name)
+
+	// Auto-subscribe session to the new group
+	wc.autoSubscribe(ctx, groupJID)
+
+	response := &types.CreateGroupResponse{
+		Success:      true,
+		GroupJID:     groupJID,
+		Name:         info.Name,
+		CreatedAt:    info.GroupCreated.Unix(),
+		Participants: []types.GroupParticipantResult{},
+	}
+	for _, participant := range info.Participants {
+		if !wc.isOwnParticipant(participant) {
+			response.Participants = append(response.Participants, participantResult(participant, whatsmeow.ParticipantChangeAdd))
+		}
+	}
+
+	// The description can only be set once the group exists
+	if description != "" {
+		if err := wc.client.SetGroupTopic(info.JID, "", "", description); err != nil {
+			log.Printf("Failed to set description of new group %s: %v", groupJID, err)
+		} else {
+			response.Description = description
+		}
+	}
+
+	return response, nil
+}
+
+// GetGroupInfo returns the metadata, settings and participants of a group we are a member of
+func (wc *WhatsmeowClient) GetGroupInfo(ctx context.Context, groupJID string) (*types.GroupInfoResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := wc.client.GetGroupInfo(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	return groupInfoResponse(info), nil
+}
+
+// UpdateGroupParticipants adds, promotes, demotes and removes group participants in one call
+// Changes are applied in that order so newly added participants can be promoted right away.
+// A failing change is reported per participant and does not stop the remaining changes.
+func (wc *WhatsmeowClient) UpdateGroupParticipants(ctx context.Context, groupJID string, add, remove, promote, demote []string) (*types.UpdateGroupParticipantsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []struct {
+		action       whatsmeow.ParticipantChange
+		participants []string
+	}{
+		{whatsmeow.ParticipantChangeAdd, add},
+		{whatsmeow.ParticipantChangePromote, promote},
+		{whatsmeow.ParticipantChangeDemote, demote},
+		{whatsmeow.ParticipantChangeRemove, remove},
+	}
+
+	// Validate all participants before changing anything
+	participantJIDs := make([][]waTypes.JID, len(changes))
+	for i, change := range changes {
+		participantJIDs[i], err = parseParticipantJIDs(change.participants)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &types.UpdateGroupParticipantsResponse{
+		Success:  true,
+		GroupJID: groupJID,
+		Results:  []types.GroupParticipantResult{},
+	}
+	for i, change := range changes {
+		if len(participantJIDs[i]) == 0 {
+			continue
+		}
+
+		updated, err := wc.client.UpdateGroupParticipants(jid, participantJIDs[i], change.action)
+		if err != nil {
+			log.Printf("Failed to %s participants of group %s: %v", change.action, groupJID, err)
+			for _, participant := range participantJIDs[i] {
+				response.Results = append(response.Results, types.GroupParticipantResult{
+					JID:    participant.String(),
+					Action: string(change.action),
+					Error:  err.Error(),
+				})
+			}
+			continue
+		}
+
+		for _, participant := range updated {
+			response.Results = append(response.Results, participantResult(participant, change.action))
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+// LeaveGroup leaves a group
+func (wc *WhatsmeowClient) LeaveGroup(ctx context.Context, groupJID string) (*types.GroupUpdateResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wc.client.LeaveGroup(jid); err != nil {
+		return nil, fmt.Errorf("failed to leave group: %w", err)
+	}
+
+	log.Printf("Left group %s", groupJID)
+
+	return &types.GroupUpdateResponse{
+		Success:  true,
+		GroupJID: groupJID,
+	}, nil
+}
+
+// SetGroupName changes the name (subject) of a group
+func (wc *WhatsmeowClient) SetGroupName(ctx context.Context, groupJID, name string) (*types.GroupUpdateResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("group name is required")
+	}
+
+	if err := wc.client.SetGroupName(jid, name); err != nil {
+		return nil, fmt.Errorf("failed to set group name: %w", err)
+	}
+
+	return &types.GroupUpdateResponse{
+		Success:  true,
+		GroupJID: groupJID,
+		Name:     name,
+	}, nil
+}
+
+// SetGroupDescription changes the description (topic) of a group, an empty description removes it
+func (wc *WhatsmeowClient) SetGroupDescription(ctx context.Context, groupJID, description string) (*types.GroupUpdateResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	// whatsmeow looks up the current topic ID, which WhatsApp requires to replace the description
+	if err := wc.client.SetGroupTopic(jid, "", "", description); err != nil {
+		return nil, fmt.Errorf("failed to set group description: %w", err)
+	}
+
+	return &types.GroupUpdateResponse{
+		Success:     true,
+		GroupJID:    groupJID,
+		Description: description,
+	}, nil
+}
+
+// SetGroupPhoto changes the photo of a group, nil data removes the current photo
+// The image is cropped to a square and converted to JPEG as required by WhatsApp
+func (wc *WhatsmeowClient) SetGroupPhoto(ctx context.Context, groupJID string, data []byte) (*types.GroupPhotoResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	var photo []byte
+	if data != nil {
+		photo, err = media.ProfilePhoto(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pictureID, err := wc.client.SetGroupPhoto(jid, photo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set group photo: %w", err)
+	}
+
+	response := &types.GroupPhotoResponse{
+		Success:  true,
+		GroupJID: groupJID,
+		Removed:  photo == nil,
+	}
+	if photo != nil {
+		response.PictureID = pictureID
+	}
+
+	return response, nil
+}
+
+// groupInfoResponse converts whatsmeow group info into the tool response format
+func groupInfoResponse(info *waTypes.GroupInfo) *types.GroupInfoResponse {
+	response := &types.GroupInfoResponse{
+		Success:              true,
+		GroupJID:             info.JID.String(),
+		Name:                 info.Name,
+		Topic:                info.Topic,
+		CreatedAt:            info.GroupCreated.Unix(),
+		Announce:             info.IsAnnounce,
+		Locked:               info.IsLocked,
+		JoinApprovalRequired: info.IsJoinApprovalRequired,
+		MemberAddMode:        string(info.MemberAddMode),
+		IsCommunity:          info.IsParent,
+		ParticipantCount:     len(info.Participants),
+		Participants:         make([]types.GroupParticipant, 0, len(info.Participants)),
+	}
+	if info.IsEphemeral {
+		response.DisappearingTimer = info.DisappearingTimer
+	}
+	if !info.NameSetAt.IsZero() {
+		response.NameSetAt = info.NameSetAt.Unix()
+		response.NameSetBy = preferPhoneNumber(info.NameSetBy, info.NameSetByPN)
+	}
+	if info.Topic != "" && !info.TopicSetAt.IsZero() {
+		response.TopicSetAt = info.TopicSetAt.Unix()
+		response.TopicSetBy = preferPhoneNumber(info.TopicSetBy, info.TopicSetByPN)
+	}
+	response.Owner = preferPhoneNumber(info.OwnerJID, info.OwnerPN)
+	if !info.LinkedParentJID.IsEmpty() {
+		response.LinkedParentJID = info.LinkedParentJID.String()
+	}
+
+	for _, participant := range info.Participants {
+		member := types.GroupParticipant{
+			JID:          participant.JID.String(),
+			IsAdmin:      participant.IsAdmin,
+			IsSuperAdmin: participant.IsSuperAdmin,
+			DisplayName:  participant.DisplayName,
+		}
+		if !participant.PhoneNumber.IsEmpty() {
+			member.PhoneNumber = participant.PhoneNumber.String()
+		}
+		if !participant.LID.IsEmpty() {
+			member.LID = participant.LID.String()
+		}
+		response.Participants = append(response.Participants, member)
+	}
+
+	return response
+}
+
+// preferPhoneNumber returns the phone number JID of a user if known, falling back to the given JID
+func preferPhoneNumber(jid, phoneNumber waTypes.JID) string {
+	if !phoneNumber.IsEmpty() {
+		return phoneNumber.String()
+	}
+	if !jid.IsEmpty() {
+		return jid.String()
+	}
+	return ""
+}
+
+// parseGroupJID parses a group JID and ensures it refers to a group
+func parseGroupJID(groupJID string) (waTypes.JID, error) {
+	jid, err := waTypes.ParseJID(groupJID)
+	if err != nil {
+		return jid, fmt.Errorf("invalid group JID: %w", err)
+	}
+	if jid.Server != waTypes.GroupServer {
+		return jid, fmt.Errorf("%s is not a group JID", groupJID)
+	}
+	return jid, nil
+}
+
+// parseParticipantJIDs parses a list of user JIDs, accepting plain phone numbers as well
+func parseParticipantJIDs(participants []string) ([]waTypes.JID, error) {
+	jids := make([]waTypes.JID, 0, len(participants))
+	for _, participant := range participants {
+		participant = strings.TrimSpace(participant)
+		if !strings.Contains(participant, "@") {
+			participant = strings.TrimPrefix(participant, "+") + "@" + waTypes.DefaultUserServer
+		}
+
+		jid, err := waTypes.ParseJID(participant)
+		if err != nil {
+			return nil, fmt.Errorf("invalid participant JID %q: %w", participant, err)
+		}
+		if jid.Server != waTypes.DefaultUserServer && jid.Server != waTypes.HiddenUserServer {
+			return nil, fmt.Errorf("%s is not a user JID", participant)
+		}
+		jids = append(jids, jid.ToNonAD())
+	}
+	return jids, nil
+}
+
+// participantResult converts a participant returned for a group change into its result
+func participantResult(participant waTypes.GroupParticipant, action whatsmeow.ParticipantChange) types.GroupParticipantResult {
+	result := types.GroupParticipantResult{
+		JID:       participant.JID.String(),
+		Action:    string(action),
+		Success:   participant.Error == 0,
+		ErrorCode: participant.Error,
+		Error:     participantErrorReason(action, participant.Error),
+	}
+	if !participant.PhoneNumber.IsEmpty() {
+		result.PhoneNumber = participant.PhoneNumber.String()
+	}
+	if participant.AddRequest != nil {
+		result.AddRequestCode = participant.AddRequest.Code
+		if !participant.AddRequest.Expiration.IsZero() {
+			result.AddRequestExpiration = participant.AddRequest.Expiration.Unix()
+		}
+	}
+	return result
+}
+
+// isOwnParticipant reports whether a group participant is our own account
+func (wc *WhatsmeowClient) isOwnParticipant(participant waTypes.GroupParticipant) bool {
+	ownID := wc.client.Store.ID
+	if ownID == nil {
+		return false
+	}
+	return participant.JID.User == ownID.User ||
+		participant.PhoneNumber.User == ownID.User ||
+		(!wc.client.Store.LID.IsEmpty() && participant.JID.User == wc.client.Store.LID.User)
+}
+
+// participantErrorReason describes the error codes WhatsApp returns for individual participants
+func participantErrorReason(action whatsmeow.ParticipantChange, code int) string {
+	switch code {
+	case 0:
+		return ""
+	case 401:
+		return "blocked: the user has blocked you or you have blocked them"
+	case 403:
+		return "privacy settings do not allow adding this user, send them an invite instead"
+	case 404:
+		if action == whatsmeow.ParticipantChangeAdd {
+			return "not on WhatsApp"
+		}
+		return "not a participant of the group"
+	case 408:
+		return "the user recently left the group and cannot be added yet"
+	case 409:
+		return "already a participant of the group"
+	case 500:
+		return "the group is full"
+	default:
+		return fmt.Sprintf("failed with error code %d", code)
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 	"whatsmeow-mcp/internal/types"
 )
 
@@ -14,14 +15,78 @@ type WhatsAppClientInterface interface {
 
 	// Message methods
 	SendMessage(ctx context.Context, to, text, quotedMessageID string) (*types.MessageResponse, error)
-	GetChatMessages(chatJID string, count int, beforeMessageID string) []types.Message
+	SendImageMessage(ctx context.Context, to string, data []byte, caption, quotedMessageID string) (*types.MediaMessageResponse, error)
+	SendDocumentMessage(ctx context.Context, to string, data []byte, filename, mimeType, caption, quotedMessageID string) (*types.MediaMessageResponse, error)
+	SendAudioMessage(ctx context.Context, to string, data []byte, ptt bool, quotedMessageID string) (*types.MediaMessageResponse, error)
+	SendVideoMessage(ctx context.Context, to string, data, thumbnailImage []byte, caption string, gifPlayback bool, quotedMessageID string) (*types.MediaMessageResponse, error)
+	SendLocationMessage(ctx context.Context, to string, latitude, longitude float64, name, address, quotedMessageID string) (*types.LocationMessageResponse, error)
+	SendContactMessage(ctx context.Context, to string, vcards []string, quotedMessageID string) (*types.ContactMessageResponse, error)
+	SendReaction(ctx context.Context, chatJID, messageID, senderJID, emoji string) (*types.ReactionResponse, error)
+	EditMessage(ctx context.Context, chatJID, messageID, text string) (*types.EditMessageResponse, error)
+	DeleteMessage(ctx context.Context, chatJID, messageID, senderJID string) (*types.DeleteMessageResponse, error)
+	CreatePoll(ctx context.Context, to, question string, options []string, selectableCount int) (*types.PollMessageResponse, error)
+	GetPollResults(ctx context.Context, messageID string) (*types.PollResultsResponse, error)
+	DownloadMedia(ctx context.Context, messageID string, includeDeleted bool) (*types.DownloadMediaResponse, []byte, error)
+	GetChatMessages(chatJID string, count int, beforeMessageID string, includeDeleted bool) []types.Message
 	GetUnreadMessages(chatJID string, count int) []types.Message
 	GetAllMessages() []types.Message
 	AddMessage(message types.Message)
 	MarkMessagesAsRead(chatJID string) error
 
+	// Chat methods
+	GetAllChats(chatType, labelID, sortBy string, count, offset int) (*types.ChatsResponse, error)
+	ArchiveChat(ctx context.Context, jid string, archive bool) (*types.ChatSettingsResponse, error)
+	PinChat(ctx context.Context, jid string, pin bool) (*types.ChatSettingsResponse, error)
+	MuteChat(ctx context.Context, jid string, mute bool, duration time.Duration) (*types.ChatSettingsResponse, error)
+	MarkChatUnread(ctx context.Context, jid string, unread bool) (*types.ChatSettingsResponse, error)
+
+	// Group methods
+	CreateGroup(ctx context.Context, name string, participants []string, description string) (*types.CreateGroupResponse, error)
+	GetGroupInfo(ctx context.Context, groupJID string) (*types.GroupInfoResponse, error)
+	UpdateGroupParticipants(ctx context.Context, groupJID string, add, remove, promote, demote []string) (*types.UpdateGroupParticipantsResponse, error)
+	GetGroupInviteLink(ctx context.Context, groupJID string, reset bool) (*types.GroupInviteLinkResponse, error)
+	GetGroupInfoFromLink(ctx context.Context, link string) (*types.GroupInfoResponse, error)
+	GetGroupInfoFromInvite(ctx context.Context, messageID string) (*types.GroupInfoResponse, error)
+	JoinGroupWithLink(ctx context.Context, link string) (*types.JoinGroupResponse, error)
+	JoinGroupWithInvite(ctx context.Context, messageID string) (*types.JoinGroupResponse, error)
+	GetGroupJoinRequests(ctx context.Context, groupJID string) (*types.GroupJoinRequestsResponse, error)
+	UpdateGroupJoinRequests(ctx context.Context, groupJID string, approve, reject []string) (*types.UpdateGroupParticipantsResponse, error)
+	SetGroupJoinApproval(ctx context.Context, groupJID string, enabled bool) (*types.GroupJoinApprovalResponse, error)
+	LeaveGroup(ctx context.Context, groupJID string) (*types.GroupUpdateResponse, error)
+	SetGroupName(ctx context.Context, groupJID, name string) (*types.GroupUpdateResponse, error)
+	SetGroupDescription(ctx context.Context, groupJID, description string) (*types.GroupUpdateResponse, error)
+	SetGroupPhoto(ctx context.Context, groupJID string, data []byte) (*types.GroupPhotoResponse, error)
+
+	// Community methods
+	CreateCommunity(ctx context.Context, name, description string, joinApproval bool) (*types.CreateCommunityResponse, error)
+	GetCommunitySubGroups(ctx context.Context, communityJID string) (*types.CommunitySubGroupsResponse, error)
+	UpdateCommunityGroups(ctx context.Context, communityJID string, link, unlink []string) (*types.UpdateCommunityGroupsResponse, error)
+	SendCommunityAnnouncement(ctx context.Context, communityJID, text string) (*types.CommunityAnnouncementResponse, error)
+
 	// Contact methods
 	IsOnWhatsApp(phones []string) ([]types.WhatsAppCheckResult, error)
+	GetContacts(query string, count int) []types.Contact
+	GetUserInfo(ctx context.Context, jids []string, refresh bool) (*types.UserInfoResponse, error)
+	GetProfilePictureInfo(ctx context.Context, jid string, preview, refresh bool) (*types.ProfilePictureResponse, error)
+	DownloadProfilePicture(ctx context.Context, url string) ([]byte, error)
+
+	// Business methods
+	GetBusinessProfile(ctx context.Context, jid string, refresh bool) (*types.BusinessProfileResponse, error)
+	GetBusinessCatalog(ctx context.Context, jid string, count int, cursor string) (*types.BusinessCatalogResponse, error)
+	GetBusinessCollections(ctx context.Context, jid string, count int) (*types.BusinessCollectionsResponse, error)
+
+	// Label methods
+	GetLabels() (*types.LabelsResponse, error)
+	CreateLabel(ctx context.Context, name string, color *int) (*types.LabelResponse, error)
+	EditLabel(ctx context.Context, labelID, name string, color *int, remove bool) (*types.LabelResponse, error)
+	LabelChat(ctx context.Context, jid, labelID string, labeled bool) (*types.LabelAssociationResponse, error)
+	LabelMessage(ctx context.Context, chatJID, messageID, labelID string, labeled bool) (*types.LabelAssociationResponse, error)
+
+	// Presence methods
+	SendPresence(presence string) (*types.PresenceResponse, error)
+	SendChatPresence(ctx context.Context, jid, state string) (*types.ChatPresenceResponse, error)
+	SimulateTyping(ctx context.Context, jid, text string) (time.Duration, error)
+	SubscribePresence(ctx context.Context, jid string) (*types.PresenceSubscriptionResponse, error)
 
 	// Subscription methods
 	GetSubscriptionManager() *SubscriptionManager
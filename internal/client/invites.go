@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+var (
+	// ErrInviteLinkInvalid is returned when a group invite link is malformed or unknown
+	ErrInviteLinkInvalid = whatsmeow.ErrInviteLinkInvalid
+	// ErrInviteLinkRevoked is returned when a group invite link has been revoked
+	ErrInviteLinkRevoked = whatsmeow.ErrInviteLinkRevoked
+	// ErrInviteLinkUnauthorized is returned when we are not allowed to see a group's invite link
+	ErrInviteLinkUnauthorized = whatsmeow.ErrGroupInviteLinkUnauthorized
+)
+
+// GetGroupInviteLink returns the invite link of a group, optionally revoking the current link and creating a new one
+func (wc *WhatsmeowClient) GetGroupInviteLink(ctx context.Context, groupJID string, reset bool) (*types.GroupInviteLinkResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := wc.client.GetGroupInviteLink(jid, reset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group invite link: %w", err)
+	}
+
+	if reset {
+		log.Printf("Reset invite link of group %s", groupJID)
+	}
+
+	return &types.GroupInviteLinkResponse{
+		Success:    true,
+		GroupJID:   groupJID,
+		InviteLink: link,
+		InviteCode: inviteCode(link),
+		Reset:      reset,
+	}, nil
+}
+
+// GetGroupInfoFromLink returns information about the group behind an invite link without joining it
+func (wc *WhatsmeowClient) GetGroupInfoFromLink(ctx context.Context, link string) (*types.GroupInfoResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	info, err := wc.client.GetGroupInfoFromLink(inviteCode(link))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve invite link: %w", err)
+	}
+
+	return groupInfoResponse(info), nil
+}
+
+// GetGroupInfoFromInvite returns information about the group of a received invite message without joining it
+func (wc *WhatsmeowClient) GetGroupInfoFromInvite(ctx context.Context, messageID string) (*types.GroupInfoResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	groupJID, inviter, invite, err := wc.loadGroupInvite(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := wc.client.GetGroupInfoFromInvite(groupJID, inviter, invite.Code, invite.Expiration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve group invite: %w", err)
+	}
+
+	return groupInfoResponse(info), nil
+}
+
+// JoinGroupWithLink joins a group via invite link
+// Groups that require admin approval only receive a join request, reported as pending
+// Automatically subscribes the caller's MCP session to messages from the joined group
+func (wc *WhatsmeowClient) JoinGroupWithLink(ctx context.Context, link string) (*types.JoinGroupResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	code := inviteCode(link)
+
+	// whatsmeow does not report whether the join needs approval, the link info tells us
+	info, err := wc.client.GetGroupInfoFromLink(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve invite link: %w", err)
+	}
+
+	jid, err := wc.client.JoinGroupWithLink(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join group: %w", err)
+	}
+
+	response := &types.JoinGroupResponse{
+		Success:         true,
+		GroupJID:        jid.String(),
+		Name:            info.Name,
+		PendingApproval: info.IsJoinApprovalRequired,
+	}
+	if response.PendingApproval {
+		log.Printf("Requested to join group %s", response.GroupJID)
+	} else {
+		log.Printf("Joined group %s via invite link", response.GroupJID)
+		wc.autoSubscribe(ctx, response.GroupJID)
+	}
+
+	return response, nil
+}
+
+// JoinGroupWithInvite joins the group of a received group invite message
+// Automatically subscribes the caller's MCP session to messages from the joined group
+func (wc *WhatsmeowClient) JoinGroupWithInvite(ctx context.Context, messageID string) (*types.JoinGroupResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	groupJID, inviter, invite, err := wc.loadGroupInvite(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wc.client.JoinGroupWithInvite(groupJID, inviter, invite.Code, invite.Expiration); err != nil {
+		return nil, fmt.Errorf("failed to join group: %w", err)
+	}
+
+	log.Printf("Joined group %s via invite from %s", groupJID, inviter)
+
+	// Auto-subscribe session to the joined group
+	wc.autoSubscribe(ctx, groupJID.String())
+
+	return &types.JoinGroupResponse{
+		Success:  true,
+		GroupJID: groupJID.String(),
+		Name:     invite.GroupName,
+	}, nil
+}
+
+// loadGroupInvite looks up a stored group invite message and returns the group, the inviter and the invite
+func (wc *WhatsmeowClient) loadGroupInvite(ctx context.Context, messageID string) (waTypes.JID, waTypes.JID, *types.GroupInvite, error) {
+	message, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+	if errors.Is(err, database.ErrMessageNotFound) {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("message %s not found in history", messageID)
+	}
+	if err != nil {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, err
+	}
+
+	// Invites deleted for everyone are no longer usable
+	if message.DeletedAt != 0 {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("group invite %s: %w", messageID, database.ErrMessageDeleted)
+	}
+
+	invite := message.GroupInvite
+	if invite == nil {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("message %s is not a group invite, it is of type %s", messageID, message.MessageType)
+	}
+	if invite.Expiration > 0 && time.Now().Unix() > invite.Expiration {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("group invite expired on %s", time.Unix(invite.Expiration, 0).UTC().Format(time.RFC3339))
+	}
+
+	groupJID, err := parseGroupJID(invite.GroupJID)
+	if err != nil {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, err
+	}
+	if wc.isOwnSender(message.From) {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("message %s is an invite sent by us", messageID)
+	}
+	inviter, err := waTypes.ParseJID(message.From)
+	if err != nil {
+		return waTypes.EmptyJID, waTypes.EmptyJID, nil, fmt.Errorf("invalid inviter JID: %w", err)
+	}
+
+	// Senders are stored with their device, the invite belongs to the user
+	return groupJID, inviter.ToNonAD(), invite, nil
+}
+
+// inviteCode extracts the invite code from a group invite link, or returns the input if it already is a code
+func inviteCode(link string) string {
+	link = strings.TrimSpace(link)
+	link = strings.TrimPrefix(link, "http://")
+	link = strings.TrimPrefix(link, "https://")
+	link = strings.TrimPrefix(link, "chat.whatsapp.com/")
+	link = strings.TrimPrefix(link, "invite/")
+	if idx := strings.IndexAny(link, "?#"); idx != -1 {
+		link = link[:idx]
+	}
+	return strings.TrimSuffix(link, "/")
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// GetGroupJoinRequests returns the pending requests to join a group that requires admin approval
+// Automatically subscribes the caller's MCP session to the group, so it receives new join requests
+func (wc *WhatsmeowClient) GetGroupJoinRequests(ctx context.Context, groupJID string) (*types.GroupJoinRequestsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := wc.client.GetGroupRequestParticipants(jid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get join requests: %w", err)
+	}
+
+	// Auto-subscribe session to this group
+	wc.autoSubscribe(ctx, jid.String())
+
+	response := &types.GroupJoinRequestsResponse{
+		Success:  true,
+		GroupJID: groupJID,
+		Count:    len(requests),
+		Requests: make([]types.GroupJoinRequest, 0, len(requests)),
+	}
+	for _, request := range requests {
+		response.Requests = append(response.Requests, types.GroupJoinRequest{
+			JID:         request.JID.String(),
+			RequestedAt: request.RequestedAt.Unix(),
+		})
+	}
+
+	return response, nil
+}
+
+// UpdateGroupJoinRequests approves and rejects pending join requests of a group
+// A failing change is reported per requester and does not stop the remaining changes
+// Automatically subscribes the caller's MCP session to the group, so it receives new join requests
+func (wc *WhatsmeowClient) UpdateGroupJoinRequests(ctx context.Context, groupJID string, approve, reject []string) (*types.UpdateGroupParticipantsResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []struct {
+		action     whatsmeow.ParticipantRequestChange
+		requesters []string
+	}{
+		{whatsmeow.ParticipantChangeApprove, approve},
+		{whatsmeow.ParticipantChangeReject, reject},
+	}
+
+	// Validate all requesters before changing anything
+	requesterJIDs := make([][]waTypes.JID, len(changes))
+	for i, change := range changes {
+		requesterJIDs[i], err = parseParticipantJIDs(change.requesters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Auto-subscribe session to this group
+	wc.autoSubscribe(ctx, jid.String())
+
+	response := &types.UpdateGroupParticipantsResponse{
+		Success:  true,
+		GroupJID: groupJID,
+		Results:  []types.GroupParticipantResult{},
+	}
+	for i, change := range changes {
+		if len(requesterJIDs[i]) == 0 {
+			continue
+		}
+
+		updated, err := wc.client.UpdateGroupRequestParticipants(jid, requesterJIDs[i], change.action)
+		if err != nil {
+			log.Printf("Failed to %s join requests of group %s: %v", change.action, groupJID, err)
+			for _, requester := range requesterJIDs[i] {
+				response.Results = append(response.Results, types.GroupParticipantResult{
+					JID:    requester.String(),
+					Action: string(change.action),
+					Error:  err.Error(),
+				})
+			}
+			continue
+		}
+
+		for _, participant := range updated {
+			result := participantResult(participant, whatsmeow.ParticipantChange(change.action))
+			if participant.Error == 404 {
+				result.Error = "no pending join request from this user"
+			}
+			response.Results = append(response.Results, result)
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+
+	return response, nil
+}
+
+// SetGroupJoinApproval turns the requirement for admins to approve new members on or off
+// Automatically subscribes the caller's MCP session to the group, so it receives new join requests
+func (wc *WhatsmeowClient) SetGroupJoinApproval(ctx context.Context, groupJID string, enabled bool) (*types.GroupJoinApprovalResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	jid, err := parseGroupJID(groupJID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wc.client.SetGroupJoinApprovalMode(jid, enabled); err != nil {
+		return nil, fmt.Errorf("failed to set join approval mode: %w", err)
+	}
+
+	// Auto-subscribe session to this group
+	wc.autoSubscribe(ctx, jid.String())
+
+	return &types.GroupJoinApprovalResponse{
+		Success:              true,
+		GroupJID:             groupJID,
+		JoinApprovalRequired: enabled,
+	}, nil
+}
+
+// handleJoinRequests notifies subscribed sessions about new requests to join a group
+// whatsmeow does not parse these notifications, so they are read from the unknown changes of the event
+func (wc *WhatsmeowClient) handleJoinRequests(evt *events.GroupInfo) {
+	for _, change := range evt.UnknownChanges {
+		if change.Tag != "created_membership_requests" {
+			continue
+		}
+
+		// The requester is listed as participant, or is the sender when joining via link
+		var requester waTypes.JID
+		if participant, ok := change.GetOptionalChildByTag("participant"); ok {
+			requester = participant.AttrGetter().OptionalJIDOrEmpty("jid")
+		}
+		if requester.IsEmpty() && evt.Sender != nil {
+			requester = *evt.Sender
+		}
+		if requester.IsEmpty() {
+			continue
+		}
+		method, _ := change.Attrs["request_method"].(string)
+
+		groupJID := evt.JID.String()
+		log.Printf("New request from %s to join group %s", requester, groupJID)
+
+		// Send MCP notification to subscribed sessions
+		if wc.subscriptionManager != nil {
+			wc.subscriptionManager.NotifyJoinRequest(groupJID, requester.String(), method, evt.Timestamp.Unix())
+		}
+	}
+}
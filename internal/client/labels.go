@@ -0,0 +1,298 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// ErrLabelNotFound is returned when a label is not known, e.g. because it was deleted
+var ErrLabelNotFound = errors.New("label not found")
+
+// ErrLabelsNotSynced is returned when the labels could not be synced from the phone
+// Labels are not created before, as a new label could reuse the ID of an existing one and overwrite it
+var ErrLabelsNotSynced = errors.New("labels are not synced yet")
+
+// LabelColors is the number of colors in the WhatsApp label palette, label colors are indexes into it
+const LabelColors = 20
+
+// GetLabels lists the labels synced from WhatsApp with the number of chats and messages they are on
+// Labels are synced from the phone first, like before label changes, so the list is complete after a start
+func (wc *WhatsmeowClient) GetLabels() (*types.LabelsResponse, error) {
+	response := &types.LabelsResponse{
+		Labels:  []types.Label{},
+		Success: true,
+	}
+	if wc.ourJID == "" {
+		return response, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if wc.IsLoggedIn() {
+		if err := wc.syncLabels(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	labels, err := wc.messageStore.GetLabels(ctx, wc.ourJID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	response.Labels = labels
+	response.Count = len(labels)
+
+	return response, nil
+}
+
+// CreateLabel creates a label on all our devices
+// Without a color, labels take the next color of the palette like on the phone
+func (wc *WhatsmeowClient) CreateLabel(ctx context.Context, name string, color *int) (*types.LabelResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Label IDs are allocated from the stored labels, which must include all labels of the phone
+	if err := wc.syncLabels(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := wc.messageStore.NextLabelID(ctx, wc.ourJID)
+	if err != nil {
+		return nil, err
+	}
+
+	label := types.Label{ID: id, Name: name}
+	if color != nil {
+		label.Color = *color
+	} else if number, err := strconv.Atoi(id); err == nil {
+		label.Color = (number - 1) % LabelColors
+	}
+
+	if err := wc.client.SendAppState(ctx, appstate.BuildLabelEdit(label.ID, label.Name, int32(label.Color), false)); err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+	wc.saveLabel(label)
+
+	return &types.LabelResponse{
+		Success: true,
+		Label:   label,
+	}, nil
+}
+
+// EditLabel renames, recolors or deletes a label on all our devices
+// An empty name and nil color keep the current name and color
+func (wc *WhatsmeowClient) EditLabel(ctx context.Context, labelID, name string, color *int, remove bool) (*types.LabelResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	label, err := wc.getLabel(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		label.Name = name
+	}
+	if color != nil {
+		label.Color = *color
+	}
+
+	if err := wc.client.SendAppState(ctx, appstate.BuildLabelEdit(label.ID, label.Name, int32(label.Color), remove)); err != nil {
+		return nil, fmt.Errorf("failed to edit label: %w", err)
+	}
+
+	if remove {
+		wc.deleteLabel(label.ID)
+		label.ChatCount = 0
+		label.MessageCount = 0
+	} else {
+		wc.saveLabel(*label)
+	}
+
+	return &types.LabelResponse{
+		Success: true,
+		Label:   *label,
+		Deleted: remove,
+	}, nil
+}
+
+// LabelChat adds a label to or removes it from a chat on all our devices
+func (wc *WhatsmeowClient) LabelChat(ctx context.Context, jid, labelID string, labeled bool) (*types.LabelAssociationResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := wc.getLabel(ctx, labelID); err != nil {
+		return nil, err
+	}
+
+	if err := wc.client.SendAppState(ctx, appstate.BuildLabelChat(chat, labelID, labeled)); err != nil {
+		return nil, fmt.Errorf("failed to label chat: %w", err)
+	}
+	wc.saveLabelAssociation(labelID, chat.String(), "", labeled)
+
+	return &types.LabelAssociationResponse{
+		Success: true,
+		LabelID: labelID,
+		Chat:    chat.String(),
+		Labeled: labeled,
+	}, nil
+}
+
+// LabelMessage adds a label to or removes it from a message on all our devices
+func (wc *WhatsmeowClient) LabelMessage(ctx context.Context, chatJID, messageID, labelID string, labeled bool) (*types.LabelAssociationResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(chatJID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := wc.getLabel(ctx, labelID); err != nil {
+		return nil, err
+	}
+
+	message, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+	if errors.Is(err, database.ErrMessageNotFound) {
+		return nil, fmt.Errorf("message %s not found in history", messageID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if message.Chat != chat.String() {
+		return nil, fmt.Errorf("message %s does not belong to chat %s", messageID, chat)
+	}
+
+	if err := wc.client.SendAppState(ctx, appstate.BuildLabelMessage(chat, labelID, messageID, labeled)); err != nil {
+		return nil, fmt.Errorf("failed to label message: %w", err)
+	}
+	wc.saveLabelAssociation(labelID, chat.String(), messageID, labeled)
+
+	return &types.LabelAssociationResponse{
+		Success:   true,
+		LabelID:   labelID,
+		Chat:      chat.String(),
+		MessageID: messageID,
+		Labeled:   labeled,
+	}, nil
+}
+
+// getLabel returns a stored label, or ErrLabelNotFound
+func (wc *WhatsmeowClient) getLabel(ctx context.Context, labelID string) (*types.Label, error) {
+	if err := wc.syncLabels(ctx); err != nil {
+		return nil, err
+	}
+
+	label, err := wc.messageStore.GetLabel(ctx, wc.ourJID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	if label == nil {
+		return nil, ErrLabelNotFound
+	}
+	return label, nil
+}
+
+// syncLabels fetches the whole regular app state, which holds the labels, once after starting
+// whatsmeow does not send events again for app state synced before, so labels created before they were
+// stored here are only known after a full sync. Stops after a full sync was done for other reasons.
+func (wc *WhatsmeowClient) syncLabels(ctx context.Context) error {
+	if wc.labelsSynced.Load() {
+		return nil
+	}
+
+	wc.labelSync.Lock()
+	defer wc.labelSync.Unlock()
+	if wc.labelsSynced.Load() {
+		return nil
+	}
+
+	if err := wc.client.FetchAppState(ctx, appstate.WAPatchRegular, true, false); err != nil {
+		return fmt.Errorf("%w: %v", ErrLabelsNotSynced, err)
+	}
+	wc.labelsSynced.Store(true)
+	log.Printf("Synced labels from app state")
+
+	return nil
+}
+
+// handleLabelEdit stores a label created, edited or deleted on any device
+func (wc *WhatsmeowClient) handleLabelEdit(evt *events.LabelEdit) {
+	if evt.Action.GetDeleted() {
+		wc.deleteLabel(evt.LabelID)
+		return
+	}
+
+	wc.saveLabel(types.Label{
+		ID:           evt.LabelID,
+		Name:         evt.Action.GetName(),
+		Color:        int(evt.Action.GetColor()),
+		PredefinedID: int(evt.Action.GetPredefinedID()),
+	})
+}
+
+// handleLabelAssociationChat stores a chat being labeled or unlabeled on any device
+func (wc *WhatsmeowClient) handleLabelAssociationChat(evt *events.LabelAssociationChat) {
+	wc.saveLabelAssociation(evt.LabelID, evt.JID.String(), "", evt.Action.GetLabeled())
+}
+
+// handleLabelAssociationMessage stores a message being labeled or unlabeled on any device
+func (wc *WhatsmeowClient) handleLabelAssociationMessage(evt *events.LabelAssociationMessage) {
+	wc.saveLabelAssociation(evt.LabelID, evt.JID.String(), evt.MessageID, evt.Action.GetLabeled())
+}
+
+// saveLabel stores a label, logging failures
+func (wc *WhatsmeowClient) saveLabel(label types.Label) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SaveLabel(ctx, label, wc.ourJID); err != nil {
+		log.Printf("Failed to save label %s: %v", label.ID, err)
+	}
+}
+
+// deleteLabel marks a label as deleted and removes its associations, logging failures
+func (wc *WhatsmeowClient) deleteLabel(labelID string) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.DeleteLabel(ctx, wc.ourJID, labelID); err != nil {
+		log.Printf("Failed to delete label %s: %v", labelID, err)
+	}
+}
+
+// saveLabelAssociation stores a label being added to or removed from a chat or message, logging failures
+func (wc *WhatsmeowClient) saveLabelAssociation(labelID, chatJID, messageID string, labeled bool) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SetLabelAssociation(ctx, wc.ourJID, labelID, chatJID, messageID, labeled); err != nil {
+		log.Printf("Failed to save label %s of %s: %v", labelID, chatJID, err)
+	}
+}
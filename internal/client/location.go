@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"whatsmeow-mcp/internal/types"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// SendLocationMessage sends a location pin with optional place name and address
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendLocationMessage(ctx context.Context, to string, latitude, longitude float64, name, address, quotedMessageID string) (*types.LocationMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	if latitude < -90 || latitude > 90 {
+		return nil, fmt.Errorf("latitude must be between -90 and 90, got %f", latitude)
+	}
+	if longitude < -180 || longitude > 180 {
+		return nil, fmt.Errorf("longitude must be between -180 and 180, got %f", longitude)
+	}
+
+	locationMsg := &waProto.LocationMessage{
+		DegreesLatitude:  proto.Float64(latitude),
+		DegreesLongitude: proto.Float64(longitude),
+		ContextInfo:      buildQuoteContext(quotedMessageID),
+	}
+	if name != "" {
+		locationMsg.Name = proto.String(name)
+	}
+	if address != "" {
+		locationMsg.Address = proto.String(address)
+	}
+
+	msg := &waProto.Message{LocationMessage: locationMsg}
+
+	resp, err := wc.sendAndSave(ctx, jid, to, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send location message: %w", err)
+	}
+
+	log.Printf("Sent location message %s to %s", resp.ID, to)
+
+	return &types.LocationMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		To:              to,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		Name:            name,
+		Address:         address,
+		QuotedMessageID: quotedMessageID,
+	}, nil
+}
@@ -0,0 +1,440 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// SendImageMessage uploads an image and sends it with an optional caption
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendImageMessage(ctx context.Context, to string, data []byte, caption, quotedMessageID string) (*types.MediaMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	mimeType := media.DetectMimeType(data)
+	if mimeType != "image/jpeg" && mimeType != "image/png" {
+		return nil, fmt.Errorf("unsupported image type %s: only JPEG and PNG are supported", mimeType)
+	}
+
+	// Generate thumbnail and read dimensions
+	imageInfo, err := media.ProcessImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upload encrypted image to WhatsApp servers
+	uploaded, err := wc.client.Upload(ctx, data, whatsmeow.MediaImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload image: %w", err)
+	}
+
+	imageMsg := &waProto.ImageMessage{
+		Mimetype:      proto.String(mimeType),
+		URL:           proto.String(uploaded.URL),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSHA256: uploaded.FileEncSHA256,
+		FileSHA256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uploaded.FileLength),
+		Width:         proto.Uint32(uint32(imageInfo.Width)),
+		Height:        proto.Uint32(uint32(imageInfo.Height)),
+		JPEGThumbnail: imageInfo.Thumbnail,
+		ContextInfo:   buildQuoteContext(quotedMessageID),
+	}
+	if caption != "" {
+		imageMsg.Caption = proto.String(caption)
+	}
+
+	msg := &waProto.Message{ImageMessage: imageMsg}
+
+	mediaInfo := &types.MediaInfo{
+		MimeType:   mimeType,
+		FileLength: uploaded.FileLength,
+	}
+
+	return wc.sendMediaMessage(ctx, jid, to, msg, types.MessageTypeImage, mediaInfo, caption, quotedMessageID)
+}
+
+// SendDocumentMessage uploads a file and sends it as a document with filename, title and page count
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendDocumentMessage(ctx context.Context, to string, data []byte, filename, mimeType, caption, quotedMessageID string) (*types.MediaMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	// Detect MIME type from content and filename unless explicitly provided
+	if mimeType == "" {
+		mimeType = media.DetectDocumentMimeType(data, filename)
+	}
+
+	// Fall back to a generic filename with an extension matching the content
+	if filename == "" {
+		filename = "document" + media.ExtensionForMimeType(mimeType)
+	}
+
+	pageCount := media.PDFPageCount(data)
+
+	// Upload encrypted document to WhatsApp servers
+	uploaded, err := wc.client.Upload(ctx, data, whatsmeow.MediaDocument)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload document: %w", err)
+	}
+
+	documentMsg := &waProto.DocumentMessage{
+		Mimetype:      proto.String(mimeType),
+		Title:         proto.String(filename),
+		FileName:      proto.String(filename),
+		URL:           proto.String(uploaded.URL),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSHA256: uploaded.FileEncSHA256,
+		FileSHA256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uploaded.FileLength),
+		ContextInfo:   buildQuoteContext(quotedMessageID),
+	}
+	if pageCount > 0 {
+		documentMsg.PageCount = proto.Uint32(uint32(pageCount))
+	}
+	if caption != "" {
+		documentMsg.Caption = proto.String(caption)
+	}
+
+	msg := &waProto.Message{DocumentMessage: documentMsg}
+
+	mediaInfo := &types.MediaInfo{
+		MimeType:   mimeType,
+		FileName:   filename,
+		FileLength: uploaded.FileLength,
+		PageCount:  pageCount,
+	}
+
+	return wc.sendMediaMessage(ctx, jid, to, msg, types.MessageTypeDocument, mediaInfo, caption, quotedMessageID)
+}
+
+// SendAudioMessage uploads audio and sends it as an audio message or, if ptt is set, as a voice note
+// Voice notes must be OGG/Opus; their duration and waveform are computed for the voice note UI
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendAudioMessage(ctx context.Context, to string, data []byte, ptt bool, quotedMessageID string) (*types.MediaMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	mimeType := media.AudioMimeType(data)
+	if mimeType == "" {
+		return nil, fmt.Errorf("unsupported audio type %s: use OGG/Opus, MP3 or M4A", media.DetectMimeType(data))
+	}
+
+	// Voice notes are only rendered by WhatsApp when encoded as OGG/Opus
+	var audioInfo *media.AudioInfo
+	if mimeType == media.VoiceNoteMimeType {
+		audioInfo, err = media.ParseOggOpus(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OGG/Opus audio: %w", err)
+		}
+	} else if ptt {
+		return nil, fmt.Errorf("voice notes must be OGG/Opus, got %s", mimeType)
+	}
+
+	// Upload encrypted audio to WhatsApp servers
+	uploaded, err := wc.client.Upload(ctx, data, whatsmeow.MediaAudio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload audio: %w", err)
+	}
+
+	audioMsg := &waProto.AudioMessage{
+		Mimetype:      proto.String(mimeType),
+		URL:           proto.String(uploaded.URL),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSHA256: uploaded.FileEncSHA256,
+		FileSHA256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uploaded.FileLength),
+		PTT:           proto.Bool(ptt),
+		ContextInfo:   buildQuoteContext(quotedMessageID),
+	}
+
+	mediaInfo := &types.MediaInfo{
+		MimeType:   mimeType,
+		FileLength: uploaded.FileLength,
+	}
+	if audioInfo != nil {
+		audioMsg.Seconds = proto.Uint32(audioInfo.DurationSeconds)
+		audioMsg.Waveform = audioInfo.Waveform
+		mediaInfo.DurationSeconds = audioInfo.DurationSeconds
+	}
+
+	msg := &waProto.Message{AudioMessage: audioMsg}
+
+	messageType := types.MessageTypeAudio
+	if ptt {
+		messageType = types.MessageTypeVoice
+	}
+
+	response, err := wc.sendMediaMessage(ctx, jid, to, msg, messageType, mediaInfo, "", quotedMessageID)
+	if err != nil {
+		return nil, err
+	}
+	response.PTT = ptt
+
+	return response, nil
+}
+
+// SendVideoMessage uploads an MP4 video and sends it with an optional caption, or as a GIF if gifPlayback is set
+// The thumbnail is generated from the given frame image, or a placeholder if no frame is given
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendVideoMessage(ctx context.Context, to string, data, thumbnailImage []byte, caption string, gifPlayback bool, quotedMessageID string) (*types.MediaMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	videoInfo, err := media.ParseMP4(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate thumbnail from the provided frame, falling back to a placeholder
+	var thumbnail []byte
+	if len(thumbnailImage) > 0 {
+		imageInfo, err := media.ProcessImage(thumbnailImage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid thumbnail image: %w", err)
+		}
+		thumbnail = imageInfo.Thumbnail
+	} else {
+		thumbnail, err = media.PlaceholderThumbnail(videoInfo.Width, videoInfo.Height)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Upload encrypted video to WhatsApp servers
+	uploaded, err := wc.client.Upload(ctx, data, whatsmeow.MediaVideo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload video: %w", err)
+	}
+
+	videoMsg := &waProto.VideoMessage{
+		Mimetype:      proto.String("video/mp4"),
+		URL:           proto.String(uploaded.URL),
+		DirectPath:    proto.String(uploaded.DirectPath),
+		MediaKey:      uploaded.MediaKey,
+		FileEncSHA256: uploaded.FileEncSHA256,
+		FileSHA256:    uploaded.FileSHA256,
+		FileLength:    proto.Uint64(uploaded.FileLength),
+		Seconds:       proto.Uint32(videoInfo.DurationSeconds),
+		JPEGThumbnail: thumbnail,
+		GifPlayback:   proto.Bool(gifPlayback),
+		ContextInfo:   buildQuoteContext(quotedMessageID),
+	}
+	if videoInfo.Width > 0 && videoInfo.Height > 0 {
+		videoMsg.Width = proto.Uint32(uint32(videoInfo.Width))
+		videoMsg.Height = proto.Uint32(uint32(videoInfo.Height))
+	}
+	if caption != "" {
+		videoMsg.Caption = proto.String(caption)
+	}
+
+	msg := &waProto.Message{VideoMessage: videoMsg}
+
+	mediaInfo := &types.MediaInfo{
+		MimeType:        "video/mp4",
+		FileLength:      uploaded.FileLength,
+		DurationSeconds: videoInfo.DurationSeconds,
+	}
+
+	messageType := types.MessageTypeVideo
+	if gifPlayback {
+		messageType = types.MessageTypeGIF
+	}
+
+	response, err := wc.sendMediaMessage(ctx, jid, to, msg, messageType, mediaInfo, caption, quotedMessageID)
+	if err != nil {
+		return nil, err
+	}
+	response.GifPlayback = gifPlayback
+
+	return response, nil
+}
+
+// sendMediaMessage sends a prepared media message, subscribes the session and stores the message
+func (wc *WhatsmeowClient) sendMediaMessage(ctx context.Context, jid waTypes.JID, to string, msg *waProto.Message, messageType string, mediaInfo *types.MediaInfo, caption, quotedMessageID string) (*types.MediaMessageResponse, error) {
+	// Media download info is stored with the message, so sent media can be downloaded again later
+	resp, err := wc.sendAndSave(ctx, jid, to, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s message: %w", messageType, err)
+	}
+
+	log.Printf("Sent %s message %s to %s", messageType, resp.ID, to)
+
+	return &types.MediaMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		To:              to,
+		MessageType:     messageType,
+		MimeType:        mediaInfo.MimeType,
+		FileLength:      mediaInfo.FileLength,
+		FileName:        mediaInfo.FileName,
+		PageCount:       mediaInfo.PageCount,
+		DurationSeconds: mediaInfo.DurationSeconds,
+		Caption:         caption,
+		QuotedMessageID: quotedMessageID,
+	}, nil
+}
+
+// buildQuoteContext returns the context info for replying to a message, or nil if no message is quoted
+func buildQuoteContext(quotedMessageID string) *waProto.ContextInfo {
+	if quotedMessageID == "" {
+		return nil
+	}
+	return &waProto.ContextInfo{
+		StanzaID: proto.String(quotedMessageID),
+	}
+}
+
+// DownloadMedia returns the decrypted contents of a stored media message
+// Media is downloaded from WhatsApp servers on first request and cached privately in the media cache afterwards
+func (wc *WhatsmeowClient) DownloadMedia(ctx context.Context, messageID string, includeDeleted bool) (*types.DownloadMediaResponse, []byte, error) {
+	if !wc.IsLoggedIn() {
+		return nil, nil, fmt.Errorf("not logged in")
+	}
+	if wc.mediaStore == nil {
+		return nil, nil, fmt.Errorf("media store is not configured")
+	}
+
+	record, err := wc.messageStore.GetMedia(ctx, wc.ourJID, messageID, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &types.DownloadMediaResponse{
+		Success:     true,
+		MessageID:   record.MessageID,
+		MessageType: record.MediaType,
+		MimeType:    record.MimeType,
+		FileName:    record.FileName,
+	}
+
+	// Serve from cache if the file is still on disk
+	if record.LocalPath != "" {
+		data, err := wc.mediaStore.ReadMediaFile(record.LocalPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if data != nil {
+			response.Cached = true
+			return wc.mediaResponse(response, data)
+		}
+	}
+
+	// Download and decrypt from WhatsApp servers
+	data, err := wc.client.DownloadMediaWithPath(ctx, record.DirectPath, record.FileEncSHA256, record.FileSHA256,
+		record.MediaKey, int(record.FileLength), whatsmeowMediaType(record.MediaType), "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to download media: %w", err)
+	}
+
+	localPath, err := wc.mediaStore.SaveMediaFile(record.MessageID, record.MimeType, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := wc.messageStore.SetMediaLocalPath(ctx, wc.ourJID, record.MessageID, localPath); err != nil {
+		log.Printf("Failed to save media cache path: %v", err)
+	}
+
+	log.Printf("Downloaded %s media for message %s (%d bytes)", record.MediaType, record.MessageID, len(data))
+
+	return wc.mediaResponse(response, data)
+}
+
+// mediaResponse completes a download response, publishing the file with a link like QR code images
+func (wc *WhatsmeowClient) mediaResponse(response *types.DownloadMediaResponse, data []byte) (*types.DownloadMediaResponse, []byte, error) {
+	response.FileLength = uint64(len(data))
+	url, err := wc.mediaStore.PublishMediaFile(response.MessageID, response.MimeType, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	response.URL = url
+	return response, data, nil
+}
+
+// removeMediaFiles deletes the cached and published files of a message deleted for everyone, so its links stop working
+// The download metadata is kept, so the media can still be requested with includeDeleted
+func (wc *WhatsmeowClient) removeMediaFiles(ctx context.Context, messageID string) {
+	if wc.mediaStore == nil {
+		return
+	}
+
+	record, err := wc.messageStore.GetMedia(ctx, wc.ourJID, messageID, true)
+	if errors.Is(err, database.ErrMediaNotFound) {
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to get media of deleted message %s: %v", messageID, err)
+		return
+	}
+
+	if err := wc.mediaStore.RemoveMediaFiles(record.MessageID, record.MimeType, record.LocalPath); err != nil {
+		log.Printf("Failed to remove media files of deleted message %s: %v", messageID, err)
+		return
+	}
+	if record.LocalPath != "" {
+		if err := wc.messageStore.SetMediaLocalPath(ctx, wc.ourJID, messageID, ""); err != nil {
+			log.Printf("Failed to clear media cache path: %v", err)
+		}
+	}
+}
+
+// whatsmeowMediaType maps a stored message type to the whatsmeow media type used for decryption keys
+func whatsmeowMediaType(messageType string) whatsmeow.MediaType {
+	switch messageType {
+	case types.MessageTypeImage, types.MessageTypeSticker:
+		return whatsmeow.MediaImage
+	case types.MessageTypeVideo, types.MessageTypeGIF:
+		return whatsmeow.MediaVideo
+	case types.MessageTypeAudio, types.MessageTypeVoice:
+		return whatsmeow.MediaAudio
+	default:
+		return whatsmeow.MediaDocument
+	}
+}
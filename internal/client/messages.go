@@ -0,0 +1,330 @@
+package client
+
+import (
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+	"whatsmeow-mcp/internal/vcard"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// mediaMessage is implemented by all downloadable WhatsApp media message types
+type mediaMessage interface {
+	GetDirectPath() string
+	GetMediaKey() []byte
+	GetFileSHA256() []byte
+	GetFileEncSHA256() []byte
+	GetMimetype() string
+	GetFileLength() uint64
+	GetContextInfo() *waProto.ContextInfo
+}
+
+// unwrapMessage returns the inner message of ephemeral, view once, captioned document and edit wrappers
+func unwrapMessage(msg *waProto.Message) *waProto.Message {
+	for msg != nil {
+		switch {
+		case msg.GetEphemeralMessage() != nil:
+			msg = msg.GetEphemeralMessage().GetMessage()
+		case msg.GetViewOnceMessage() != nil:
+			msg = msg.GetViewOnceMessage().GetMessage()
+		case msg.GetViewOnceMessageV2() != nil:
+			msg = msg.GetViewOnceMessageV2().GetMessage()
+		case msg.GetDocumentWithCaptionMessage() != nil:
+			msg = msg.GetDocumentWithCaptionMessage().GetMessage()
+		case msg.GetEditedMessage() != nil:
+			msg = msg.GetEditedMessage().GetMessage()
+		default:
+			return msg
+		}
+	}
+	return msg
+}
+
+// extractMessageContent fills the text, type, quoted message and media metadata of message from msg.
+// For media messages it also returns the information needed to download the media later.
+func extractMessageContent(msg *waProto.Message, message *types.Message) *database.MediaRecord {
+	msg = unwrapMessage(msg)
+	if msg == nil {
+		return nil
+	}
+
+	message.MessageType = types.MessageTypeText
+
+	switch {
+	case msg.GetConversation() != "":
+		message.Text = msg.GetConversation()
+	case msg.GetExtendedTextMessage() != nil:
+		message.Text = msg.GetExtendedTextMessage().GetText()
+		setQuotedMessageID(msg.GetExtendedTextMessage().GetContextInfo(), message)
+	case msg.GetLocationMessage() != nil:
+		location := msg.GetLocationMessage()
+		message.MessageType = types.MessageTypeLocation
+		message.Text = location.GetComment()
+		message.Location = &types.LocationInfo{
+			Latitude:  location.GetDegreesLatitude(),
+			Longitude: location.GetDegreesLongitude(),
+			Name:      location.GetName(),
+			Address:   location.GetAddress(),
+		}
+		setQuotedMessageID(location.GetContextInfo(), message)
+	case msg.GetLiveLocationMessage() != nil:
+		location := msg.GetLiveLocationMessage()
+		message.MessageType = types.MessageTypeLiveLocation
+		message.Text = location.GetCaption()
+		message.Location = &types.LocationInfo{
+			Latitude:       location.GetDegreesLatitude(),
+			Longitude:      location.GetDegreesLongitude(),
+			AccuracyMeters: location.GetAccuracyInMeters(),
+		}
+		setQuotedMessageID(location.GetContextInfo(), message)
+	case msg.GetContactMessage() != nil:
+		contact := msg.GetContactMessage()
+		message.MessageType = types.MessageTypeContact
+		message.Contacts = []types.ContactCard{parseContactCard(contact.GetVcard(), contact.GetDisplayName())}
+		setQuotedMessageID(contact.GetContextInfo(), message)
+	case msg.GetContactsArrayMessage() != nil:
+		contacts := msg.GetContactsArrayMessage()
+		message.MessageType = types.MessageTypeContact
+		for _, contact := range contacts.GetContacts() {
+			message.Contacts = append(message.Contacts, parseContactCard(contact.GetVcard(), contact.GetDisplayName()))
+		}
+		setQuotedMessageID(contacts.GetContextInfo(), message)
+	case msg.GetGroupInviteMessage() != nil:
+		invite := msg.GetGroupInviteMessage()
+		message.MessageType = types.MessageTypeGroupInvite
+		message.Text = invite.GetCaption()
+		message.GroupInvite = &types.GroupInvite{
+			GroupJID:   invite.GetGroupJID(),
+			GroupName:  invite.GetGroupName(),
+			Code:       invite.GetInviteCode(),
+			Expiration: invite.GetInviteExpiration(),
+			Inviter:    message.From,
+		}
+		setQuotedMessageID(invite.GetContextInfo(), message)
+	case pollCreation(msg) != nil:
+		poll := pollCreation(msg)
+		message.MessageType = types.MessageTypePoll
+		message.Text = poll.GetName()
+		setQuotedMessageID(poll.GetContextInfo(), message)
+	case msg.GetImageMessage() != nil:
+		image := msg.GetImageMessage()
+		message.Text = image.GetCaption()
+		return extractMedia(image, types.MessageTypeImage, message)
+	case msg.GetStickerMessage() != nil:
+		return extractMedia(msg.GetStickerMessage(), types.MessageTypeSticker, message)
+	case msg.GetVideoMessage() != nil:
+		video := msg.GetVideoMessage()
+		message.Text = video.GetCaption()
+		messageType := types.MessageTypeVideo
+		if video.GetGifPlayback() {
+			messageType = types.MessageTypeGIF
+		}
+		record := extractMedia(video, messageType, message)
+		message.Media.DurationSeconds = video.GetSeconds()
+		return record
+	case msg.GetAudioMessage() != nil:
+		audio := msg.GetAudioMessage()
+		messageType := types.MessageTypeAudio
+		if audio.GetPTT() {
+			messageType = types.MessageTypeVoice
+		}
+		record := extractMedia(audio, messageType, message)
+		message.Media.DurationSeconds = audio.GetSeconds()
+		return record
+	case msg.GetDocumentMessage() != nil:
+		document := msg.GetDocumentMessage()
+		message.Text = document.GetCaption()
+		record := extractMedia(document, types.MessageTypeDocument, message)
+		message.Media.FileName = document.GetFileName()
+		message.Media.PageCount = int(document.GetPageCount())
+		return record
+	}
+
+	return nil
+}
+
+// extractMedia sets the media metadata of message and returns the download information of the media
+func extractMedia(media mediaMessage, messageType string, message *types.Message) *database.MediaRecord {
+	message.MessageType = messageType
+	message.Media = &types.MediaInfo{
+		MimeType:   media.GetMimetype(),
+		FileLength: media.GetFileLength(),
+	}
+	setQuotedMessageID(media.GetContextInfo(), message)
+
+	if media.GetDirectPath() == "" || len(media.GetMediaKey()) == 0 {
+		return nil
+	}
+
+	return &database.MediaRecord{
+		MessageID:     message.ID,
+		MediaType:     messageType,
+		DirectPath:    media.GetDirectPath(),
+		MediaKey:      media.GetMediaKey(),
+		FileSHA256:    media.GetFileSHA256(),
+		FileEncSHA256: media.GetFileEncSHA256(),
+		MimeType:      media.GetMimetype(),
+		FileLength:    media.GetFileLength(),
+	}
+}
+
+// extractReaction returns the reaction carried by msg, or nil if msg is not a reaction
+func extractReaction(msg *waProto.Message, chatJID, senderJID string, timestamp int64) *database.Reaction {
+	reaction := unwrapMessage(msg).GetReactionMessage()
+	if reaction == nil || reaction.GetKey().GetID() == "" {
+		return nil
+	}
+
+	// Prefer the sender timestamp, which orders reaction changes precisely
+	if reaction.GetSenderTimestampMS() > 0 {
+		timestamp = reaction.GetSenderTimestampMS() / 1000
+	}
+
+	return &database.Reaction{
+		MessageID: reaction.GetKey().GetID(),
+		ChatJID:   chatJID,
+		SenderJID: senderJID,
+		Emoji:     reaction.GetText(),
+		Timestamp: timestamp,
+	}
+}
+
+// messageEdit represents a change of the text of a previously sent message
+type messageEdit struct {
+	MessageID string
+	Sender    string // Sender of the edit, "self" for our own edits
+	Text      string
+	Timestamp int64
+}
+
+// extractEdit returns the edit carried by msg, or nil if msg is not an edit
+func extractEdit(msg *waProto.Message, senderJID string, timestamp int64) *messageEdit {
+	protocol := unwrapMessage(msg).GetProtocolMessage()
+	if protocol == nil || protocol.GetType() != waProto.ProtocolMessage_MESSAGE_EDIT || protocol.GetKey().GetID() == "" {
+		return nil
+	}
+
+	// The new content is a full message, read its text or caption
+	var edited types.Message
+	extractMessageContent(protocol.GetEditedMessage(), &edited)
+
+	if protocol.GetTimestampMS() > 0 {
+		timestamp = protocol.GetTimestampMS() / 1000
+	}
+
+	return &messageEdit{
+		MessageID: protocol.GetKey().GetID(),
+		Sender:    senderJID,
+		Text:      edited.Text,
+		Timestamp: timestamp,
+	}
+}
+
+// extractRevoke returns the ID of the message deleted by msg, or an empty string if msg is not a revoke
+func extractRevoke(msg *waProto.Message) string {
+	protocol := unwrapMessage(msg).GetProtocolMessage()
+	if protocol == nil || protocol.GetType() != waProto.ProtocolMessage_REVOKE {
+		return ""
+	}
+	return protocol.GetKey().GetID()
+}
+
+// pollCreation returns the poll creation content of msg in any of its versions, or nil if msg is not a poll
+func pollCreation(msg *waProto.Message) *waProto.PollCreationMessage {
+	switch {
+	case msg.GetPollCreationMessage() != nil:
+		return msg.GetPollCreationMessage()
+	case msg.GetPollCreationMessageV2() != nil:
+		return msg.GetPollCreationMessageV2()
+	case msg.GetPollCreationMessageV3() != nil:
+		return msg.GetPollCreationMessageV3()
+	}
+	return nil
+}
+
+// extractPoll returns the definition of the poll carried by msg, or nil if msg is not a poll
+func extractPoll(msg *waProto.Message, message types.Message) *database.Poll {
+	poll := pollCreation(unwrapMessage(msg))
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]string, 0, len(poll.GetOptions()))
+	for _, option := range poll.GetOptions() {
+		options = append(options, option.GetOptionName())
+	}
+
+	return &database.Poll{
+		MessageID:       message.ID,
+		ChatJID:         message.Chat,
+		Question:        poll.GetName(),
+		Options:         options,
+		SelectableCount: int(poll.GetSelectableOptionsCount()),
+	}
+}
+
+// historyReactions returns the reactions attached to a history sync message
+func historyReactions(webMsg *waProto.WebMessageInfo, chatJID string) []database.Reaction {
+	var reactions []database.Reaction
+	for _, reaction := range webMsg.GetReactions() {
+		reactions = append(reactions, database.Reaction{
+			MessageID: webMsg.GetKey().GetID(),
+			ChatJID:   chatJID,
+			SenderJID: historySender(reaction.GetKey()),
+			Emoji:     reaction.GetText(),
+			Timestamp: reaction.GetSenderTimestampMS() / 1000,
+		})
+	}
+	return reactions
+}
+
+// historySender returns the sender of a history sync message key, or self for our own messages
+func historySender(key *waCommon.MessageKey) string {
+	if key.GetFromMe() {
+		return "self"
+	}
+	if key.GetParticipant() != "" {
+		return key.GetParticipant()
+	}
+	return key.GetRemoteJID()
+}
+
+// parseContactCard converts a vCard into a contact card, falling back to the display name if the vCard is invalid
+func parseContactCard(raw, displayName string) types.ContactCard {
+	contact := types.ContactCard{
+		Name:  displayName,
+		VCard: raw,
+	}
+
+	card, err := vcard.Parse(raw)
+	if err != nil {
+		return contact
+	}
+
+	if card.Name != "" {
+		contact.Name = card.Name
+	}
+	contact.Organization = card.Organization
+	contact.Emails = card.Emails
+	for _, phone := range card.Phones {
+		contactPhone := types.ContactPhone{
+			Number: phone.Number,
+			Type:   phone.Type,
+		}
+		if phone.WaID != "" {
+			contactPhone.JID = waTypes.NewJID(phone.WaID, waTypes.DefaultUserServer).String()
+		}
+		contact.Phones = append(contact.Phones, contactPhone)
+	}
+
+	return contact
+}
+
+// setQuotedMessageID sets the quoted message ID of message from the context info, if any
+func setQuotedMessageID(contextInfo *waProto.ContextInfo, message *types.Message) {
+	if contextInfo != nil && contextInfo.GetStanzaID() != "" {
+		message.QuotedMessageID = contextInfo.GetStanzaID()
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// MaxPollOptions is the maximum number of options WhatsApp allows in a poll
+const MaxPollOptions = 12
+
+// CreatePoll sends a poll with the given question and options
+// selectableCount limits how many options a voter may select, 0 allows any number
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) CreatePoll(ctx context.Context, to, question string, options []string, selectableCount int) (*types.PollMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse recipient JID
+	jid, err := waTypes.ParseJID(to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient JID: %w", err)
+	}
+
+	if question == "" {
+		return nil, fmt.Errorf("poll question is required")
+	}
+	if len(options) < 2 || len(options) > MaxPollOptions {
+		return nil, fmt.Errorf("polls need between 2 and %d options, got %d", MaxPollOptions, len(options))
+	}
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if option == "" {
+			return nil, fmt.Errorf("poll options must not be empty")
+		}
+		if seen[option] {
+			return nil, fmt.Errorf("duplicate poll option %q", option)
+		}
+		seen[option] = true
+	}
+	if selectableCount < 0 || selectableCount > len(options) {
+		return nil, fmt.Errorf("selectable count must be between 0 and %d", len(options))
+	}
+
+	// whatsmeow stores the poll secret when sending, which is needed to decrypt votes later
+	msg := wc.client.BuildPollCreation(question, options, selectableCount)
+	resp, err := wc.sendAndSave(ctx, jid, to, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send poll: %w", err)
+	}
+
+	wc.storePoll(msg, types.Message{ID: resp.ID, Chat: to})
+
+	log.Printf("Sent poll %s to %s", resp.ID, to)
+
+	return &types.PollMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		To:              to,
+		Question:        question,
+		Options:         options,
+		SelectableCount: selectableCount,
+	}, nil
+}
+
+// GetPollResults returns the current tally of a stored poll
+func (wc *WhatsmeowClient) GetPollResults(ctx context.Context, messageID string) (*types.PollResultsResponse, error) {
+	if wc.ourJID == "" {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	return wc.messageStore.GetPollResults(ctx, wc.ourJID, messageID)
+}
+
+// storePoll saves the poll definition of msg, if it is a poll
+func (wc *WhatsmeowClient) storePoll(msg *waProto.Message, message types.Message) {
+	poll := extractPoll(msg, message)
+	if poll == nil || wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SavePoll(ctx, *poll, wc.ourJID); err != nil {
+		log.Printf("Failed to save poll %s: %v", poll.MessageID, err)
+	}
+}
+
+// handlePollVote decrypts an incoming poll vote and stores the voter's selection
+func (wc *WhatsmeowClient) handlePollVote(evt *events.Message) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	vote, err := wc.client.DecryptPollVote(ctx, evt)
+	if err != nil {
+		log.Printf("Failed to decrypt poll vote %s: %v", evt.Info.ID, err)
+		return
+	}
+
+	voterJID := evt.Info.Sender.ToNonAD().String()
+	if evt.Info.IsFromMe {
+		voterJID = "self"
+	}
+
+	pollUpdate := evt.Message.GetPollUpdateMessage()
+	timestamp := evt.Info.Timestamp.Unix()
+	if pollUpdate.GetSenderTimestampMS() > 0 {
+		timestamp = pollUpdate.GetSenderTimestampMS() / 1000
+	}
+
+	wc.storePollVote(ctx, evt.Info.Chat.String(), pollUpdate.GetPollCreationMessageKey().GetID(), voterJID, vote.GetSelectedOptions(), timestamp, false)
+}
+
+// storePollVote maps the selected option hashes of a vote to option names, stores the vote
+// and notifies subscribed sessions if the voter's selection changed. Votes from history sync are not notified.
+func (wc *WhatsmeowClient) storePollVote(ctx context.Context, chatJID, pollMessageID, voterJID string, selectedHashes [][]byte, timestamp int64, fromHistory bool) {
+	poll, err := wc.messageStore.GetPoll(ctx, wc.ourJID, pollMessageID)
+	if errors.Is(err, database.ErrPollNotFound) {
+		log.Printf("Ignoring vote for unknown poll %s", pollMessageID)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to load poll %s: %v", pollMessageID, err)
+		return
+	}
+
+	// Votes reference options by the SHA-256 hash of their name
+	optionHashes := whatsmeow.HashPollOptions(poll.Options)
+	selected := []string{}
+	for _, hash := range selectedHashes {
+		for i, optionHash := range optionHashes {
+			if bytes.Equal(hash, optionHash) {
+				selected = append(selected, poll.Options[i])
+				break
+			}
+		}
+	}
+
+	changed, err := wc.messageStore.SavePollVote(ctx, database.PollVote{
+		PollMessageID:   pollMessageID,
+		VoterJID:        voterJID,
+		SelectedOptions: selected,
+		Timestamp:       timestamp,
+	}, wc.ourJID)
+	if err != nil {
+		log.Printf("Failed to save poll vote: %v", err)
+		return
+	}
+	if !changed || fromHistory {
+		return
+	}
+
+	log.Printf("Poll %s vote from %s: %v", pollMessageID, voterJID, selected)
+
+	// Send MCP notification to subscribed sessions
+	if wc.subscriptionManager != nil {
+		wc.subscriptionManager.NotifyPollVote(chatJID, pollMessageID, voterJID, selected, timestamp)
+	}
+}
@@ -0,0 +1,297 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// ErrPresenceUnavailable is returned when subscribing to presence updates while our own presence is unavailable
+// WhatsApp only sends presence updates to users who are online themselves
+var ErrPresenceUnavailable = errors.New("our presence is unavailable, presence updates are only sent to online users")
+
+// ErrInvalidChat is returned when the chat to show the typing indicator in is not a valid user or group JID
+var ErrInvalidChat = errors.New("invalid chat JID")
+
+// Typing simulation timing: the typing indicator is shown for TypingDelayPerChar per character of the text,
+// but at least MinTypingDelay and at most MaxTypingDelay
+const (
+	TypingDelayPerChar = 50 * time.Millisecond
+	MinTypingDelay     = 1 * time.Second
+	MaxTypingDelay     = 10 * time.Second
+)
+
+// presenceTracker keeps our own presence and the last known presence and chat states of other users in memory
+type presenceTracker struct {
+	mutex sync.RWMutex
+	// Presence we last set, sent again after reconnecting
+	own waTypes.Presence
+	// user JID -> presence
+	users map[string]types.UserPresence
+	// chat JID -> sender JID -> chat state
+	chatStates map[string]map[string]types.ChatState
+}
+
+// newPresenceTracker creates a presence tracker, starting as available
+func newPresenceTracker() *presenceTracker {
+	return &presenceTracker{
+		own:        waTypes.PresenceAvailable,
+		users:      make(map[string]types.UserPresence),
+		chatStates: make(map[string]map[string]types.ChatState),
+	}
+}
+
+// SendPresence sets our global presence to available or unavailable
+// The presence is remembered and sent again whenever the connection is restored
+func (wc *WhatsmeowClient) SendPresence(presence string) (*types.PresenceResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	state := waTypes.Presence(presence)
+	if state != waTypes.PresenceAvailable && state != waTypes.PresenceUnavailable {
+		return nil, fmt.Errorf("invalid presence %q, must be available or unavailable", presence)
+	}
+
+	if err := wc.client.SendPresence(state); err != nil {
+		return nil, fmt.Errorf("failed to send presence: %w", err)
+	}
+
+	wc.presence.mutex.Lock()
+	wc.presence.own = state
+	wc.presence.mutex.Unlock()
+
+	return &types.PresenceResponse{
+		Success:  true,
+		Presence: presence,
+	}, nil
+}
+
+// SendChatPresence shows a typing or recording indicator in a chat, or clears it with paused
+// Automatically subscribes the caller's MCP session to this chat, so the other side's chat states are received
+func (wc *WhatsmeowClient) SendChatPresence(ctx context.Context, jid, state string) (*types.ChatPresenceResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recording is sent as composing an audio message
+	var media waTypes.ChatPresenceMedia
+	var chatPresence waTypes.ChatPresence
+	switch state {
+	case types.ChatStateComposing:
+		chatPresence = waTypes.ChatPresenceComposing
+	case types.ChatStateRecording:
+		chatPresence = waTypes.ChatPresenceComposing
+		media = waTypes.ChatPresenceMediaAudio
+	case types.ChatStatePaused:
+		chatPresence = waTypes.ChatPresencePaused
+	default:
+		return nil, fmt.Errorf("invalid chat state %q, must be composing, recording or paused", state)
+	}
+
+	if err := wc.client.SendChatPresence(chat, chatPresence, media); err != nil {
+		return nil, fmt.Errorf("failed to send chat presence: %w", err)
+	}
+
+	wc.autoSubscribe(ctx, chat.String())
+
+	return &types.ChatPresenceResponse{
+		Success: true,
+		JID:     chat.String(),
+		State:   state,
+	}, nil
+}
+
+// SimulateTyping shows the typing indicator in a chat for a time proportional to the length of text, then clears it
+// It is used before sending a message so replies do not arrive instantly. Failing to send the indicator
+// is only logged since the message should be sent anyway. Returns how long the indicator was shown,
+// or the error of ctx if it is done before the time is up. Invalid chats return ErrInvalidChat.
+func (wc *WhatsmeowClient) SimulateTyping(ctx context.Context, jid, text string) (time.Duration, error) {
+	if !wc.IsLoggedIn() {
+		return 0, fmt.Errorf("not logged in")
+	}
+
+	chat, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidChat, err)
+	}
+
+	duration := time.Duration(utf8.RuneCountInString(text)) * TypingDelayPerChar
+	duration = max(MinTypingDelay, min(duration, MaxTypingDelay))
+
+	if err := wc.client.SendChatPresence(chat, waTypes.ChatPresenceComposing, waTypes.ChatPresenceMediaText); err != nil {
+		log.Printf("Failed to send typing indicator to %s: %v", chat, err)
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if pauseErr := wc.client.SendChatPresence(chat, waTypes.ChatPresencePaused, waTypes.ChatPresenceMediaText); pauseErr != nil {
+		log.Printf("Failed to clear typing indicator in %s: %v", chat, pauseErr)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return duration, nil
+}
+
+// SubscribePresence asks WhatsApp for presence updates of a user and subscribes the caller's MCP session to them
+// Returns the presence and chat state of the user that are already known
+func (wc *WhatsmeowClient) SubscribePresence(ctx context.Context, jid string) (*types.PresenceSubscriptionResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	users, err := parseParticipantJIDs([]string{jid})
+	if err != nil {
+		return nil, err
+	}
+	user := wc.presenceUser(users[0])
+
+	wc.presence.mutex.RLock()
+	own := wc.presence.own
+	wc.presence.mutex.RUnlock()
+	if own != waTypes.PresenceAvailable {
+		return nil, ErrPresenceUnavailable
+	}
+
+	if err := wc.client.SubscribePresence(users[0]); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to presence: %w", err)
+	}
+
+	// Presence updates are sent to sessions subscribed to the user's chat
+	wc.autoSubscribe(ctx, user.String())
+
+	response := &types.PresenceSubscriptionResponse{
+		Success: true,
+		JID:     user.String(),
+	}
+
+	wc.presence.mutex.RLock()
+	defer wc.presence.mutex.RUnlock()
+	if presence, ok := wc.presence.users[user.String()]; ok {
+		response.Presence = &presence
+	}
+	if chatState, ok := wc.presence.chatStates[user.String()][user.String()]; ok {
+		response.ChatState = &chatState
+	}
+
+	return response, nil
+}
+
+// handlePresence tracks the online status of a user and notifies subscribed sessions
+func (wc *WhatsmeowClient) handlePresence(evt *events.Presence) {
+	user := wc.presenceUser(evt.From)
+	presence := types.UserPresence{
+		JID:       user.String(),
+		Available: !evt.Unavailable,
+		UpdatedAt: time.Now().Unix(),
+	}
+	if !evt.LastSeen.IsZero() {
+		presence.LastSeen = evt.LastSeen.Unix()
+	}
+
+	wc.presence.mutex.Lock()
+	wc.presence.users[presence.JID] = presence
+	wc.presence.mutex.Unlock()
+
+	if wc.subscriptionManager != nil {
+		wc.subscriptionManager.NotifyPresence(presence)
+	}
+}
+
+// handleChatPresence tracks whether a user is typing or recording in a chat and notifies subscribed sessions
+func (wc *WhatsmeowClient) handleChatPresence(evt *events.ChatPresence) {
+	// Our own other devices typing is not interesting
+	if evt.IsFromMe {
+		return
+	}
+
+	chat := evt.Chat
+	if chat.Server != waTypes.GroupServer {
+		chat = wc.presenceUser(chat)
+	}
+	sender := wc.presenceUser(evt.Sender)
+	if !evt.SenderAlt.IsEmpty() && sender.Server == waTypes.HiddenUserServer {
+		sender = evt.SenderAlt.ToNonAD()
+	}
+
+	chatState := types.ChatState{
+		Chat:      chat.String(),
+		From:      sender.String(),
+		State:     types.ChatStatePaused,
+		UpdatedAt: time.Now().Unix(),
+	}
+	if evt.State == waTypes.ChatPresenceComposing {
+		chatState.State = types.ChatStateComposing
+		if evt.Media == waTypes.ChatPresenceMediaAudio {
+			chatState.State = types.ChatStateRecording
+		}
+	}
+
+	wc.presence.mutex.Lock()
+	if wc.presence.chatStates[chatState.Chat] == nil {
+		wc.presence.chatStates[chatState.Chat] = make(map[string]types.ChatState)
+	}
+	wc.presence.chatStates[chatState.Chat][chatState.From] = chatState
+	wc.presence.mutex.Unlock()
+
+	if wc.subscriptionManager != nil {
+		wc.subscriptionManager.NotifyChatPresence(chatState)
+	}
+}
+
+// restorePresence sends the presence we last set, after connecting or once our push name is known
+// WhatsApp needs it to show our name to others and only sends presence updates to online users
+func (wc *WhatsmeowClient) restorePresence() {
+	wc.presence.mutex.RLock()
+	presence := wc.presence.own
+	wc.presence.mutex.RUnlock()
+
+	err := wc.client.SendPresence(presence)
+	if errors.Is(err, whatsmeow.ErrNoPushName) {
+		// Freshly paired devices get the push name with the first app state sync
+		log.Printf("Push name not synced yet, presence will be sent once it is")
+	} else if err != nil {
+		log.Printf("Failed to send presence: %v", err)
+	} else {
+		log.Printf("Sent presence: %s", presence)
+	}
+}
+
+// presenceUser returns the JID presence of a user is tracked under: the phone number JID if known,
+// so updates for users addressed by LID reach sessions subscribed to their phone number
+func (wc *WhatsmeowClient) presenceUser(jid waTypes.JID) waTypes.JID {
+	jid = jid.ToNonAD()
+	if jid.Server != waTypes.HiddenUserServer {
+		return jid
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if pn, err := wc.client.Store.LIDs.GetPNForLID(ctx, jid); err == nil && !pn.IsEmpty() {
+		return pn.ToNonAD()
+	}
+	return jid
+}
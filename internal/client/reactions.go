@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// SendReaction reacts to a message with an emoji, or removes our reaction if emoji is empty
+// The author of the message is looked up from history if senderJID is empty
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) SendReaction(ctx context.Context, chatJID, messageID, senderJID, emoji string) (*types.ReactionResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse chat JID
+	chat, err := waTypes.ParseJID(chatJID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat JID: %w", err)
+	}
+
+	sender, err := wc.resolveMessageSender(ctx, messageID, senderJID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := wc.client.BuildReaction(chat, sender, messageID, emoji)
+	resp, err := wc.client.SendMessage(context.Background(), chat, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send reaction: %w", err)
+	}
+
+	// Auto-subscribe session to this chat
+	wc.autoSubscribe(ctx, chatJID)
+
+	wc.storeReaction(database.Reaction{
+		MessageID: messageID,
+		ChatJID:   chatJID,
+		SenderJID: "self",
+		Emoji:     emoji,
+		Timestamp: resp.Timestamp.Unix(),
+	})
+
+	return &types.ReactionResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		Chat:            chatJID,
+		TargetMessageID: messageID,
+		Emoji:           emoji,
+		Removed:         emoji == "",
+	}, nil
+}
+
+// resolveMessageSender returns the JID of the author of a message, needed to address it in
+// reactions, edits and revokes. Uses senderJID if given, otherwise looks the message up in history.
+func (wc *WhatsmeowClient) resolveMessageSender(ctx context.Context, messageID, senderJID string) (waTypes.JID, error) {
+	if senderJID == "" {
+		message, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+		if errors.Is(err, database.ErrMessageNotFound) {
+			return waTypes.JID{}, fmt.Errorf("message %s not found in history, provide the sender JID", messageID)
+		}
+		if err != nil {
+			return waTypes.JID{}, err
+		}
+		senderJID = message.From
+	}
+
+	// Our own messages are stored with "self" as sender
+	if senderJID == "self" {
+		return wc.client.Store.ID.ToNonAD(), nil
+	}
+
+	sender, err := waTypes.ParseJID(senderJID)
+	if err != nil {
+		return waTypes.JID{}, fmt.Errorf("invalid sender JID: %w", err)
+	}
+
+	return sender.ToNonAD(), nil
+}
+
+// storeReaction saves a reaction to the database
+func (wc *WhatsmeowClient) storeReaction(reaction database.Reaction) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.SaveReaction(ctx, reaction, wc.ourJID); err != nil {
+		log.Printf("Failed to save reaction to database: %v", err)
+		return
+	}
+
+	if reaction.Emoji == "" {
+		log.Printf("Reaction of %s to message %s removed", reaction.SenderJID, reaction.MessageID)
+	} else {
+		log.Printf("Reaction %s from %s to message %s", reaction.Emoji, reaction.SenderJID, reaction.MessageID)
+	}
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// DeleteMessage deletes a message for everyone in the chat
+// Our own messages can be deleted in any chat; group admins can also delete messages of other participants
+// Automatically subscribes the caller's MCP session to messages from this chat
+func (wc *WhatsmeowClient) DeleteMessage(ctx context.Context, chatJID, messageID, senderJID string) (*types.DeleteMessageResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	// Parse chat JID
+	chat, err := waTypes.ParseJID(chatJID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat JID: %w", err)
+	}
+
+	// A stored message must belong to the chat, otherwise the revoke would target the wrong message key
+	stored, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+	if err != nil && !errors.Is(err, database.ErrMessageNotFound) {
+		return nil, err
+	}
+	if stored != nil && stored.Chat != chat.String() {
+		return nil, fmt.Errorf("message %s does not belong to chat %s", messageID, chatJID)
+	}
+
+	sender, err := wc.resolveMessageSender(ctx, messageID, senderJID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Messages of others can only be revoked by group admins
+	adminRevoke := sender.User != wc.client.Store.ID.User
+	if adminRevoke && chat.Server != waTypes.GroupServer {
+		return nil, fmt.Errorf("only your own messages can be deleted for everyone outside of groups")
+	}
+	if adminRevoke {
+		admin, err := wc.isGroupAdmin(chat, wc.client.Store.ID.ToNonAD())
+		if err != nil {
+			return nil, err
+		}
+		if !admin {
+			return nil, fmt.Errorf("only group admins can delete messages of other participants")
+		}
+	}
+
+	msg := wc.client.BuildRevoke(chat, sender, messageID)
+	resp, err := wc.client.SendMessage(context.Background(), chat, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	// Auto-subscribe session to this chat
+	wc.autoSubscribe(ctx, chatJID)
+
+	wc.markDeleted(messageID, "self", resp.Timestamp.Unix())
+
+	return &types.DeleteMessageResponse{
+		MessageID:       resp.ID,
+		Timestamp:       resp.Timestamp.Unix(),
+		Success:         true,
+		Chat:            chatJID,
+		TargetMessageID: messageID,
+		AdminRevoke:     adminRevoke,
+	}, nil
+}
+
+// applyRevoke marks a stored message as deleted after a revoke from another device or user
+// Live revokes are only honored from the author of the message, or in groups from a current admin.
+// Revokes from history sync were already applied by the phone, so admin rights are not checked again,
+// but like live revokes they must arrive in the chat of the message.
+func (wc *WhatsmeowClient) applyRevoke(chatJID, messageID, senderJID string, deletedAt int64, fromHistory bool) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	// Senders are stored without device
+	if senderJID != "self" {
+		sender, err := waTypes.ParseJID(senderJID)
+		if err != nil {
+			log.Printf("Ignoring revoke of message %s from invalid sender %s", messageID, senderJID)
+			return
+		}
+		senderJID = sender.ToNonAD().String()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	original, err := wc.messageStore.GetMessage(ctx, wc.ourJID, messageID)
+	if errors.Is(err, database.ErrMessageNotFound) {
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to get revoked message %s: %v", messageID, err)
+		return
+	}
+
+	if err := wc.checkRevoke(original, chatJID, senderJID, fromHistory); err != nil {
+		log.Printf("Ignoring revoke of message %s by %s: %v", messageID, senderJID, err)
+		return
+	}
+
+	wc.markDeleted(messageID, senderJID, deletedAt)
+}
+
+// checkRevoke returns an error if a revoke received in a chat may not delete the stored original message
+// The original must belong to the chat the revoke arrived in, also for revokes from history sync,
+// otherwise anyone could delete messages of other chats by their ID
+func (wc *WhatsmeowClient) checkRevoke(original *types.Message, chatJID, senderJID string, fromHistory bool) error {
+	if original.Chat != chatJID {
+		return fmt.Errorf("message belongs to chat %s, not %s", original.Chat, chatJID)
+	}
+	if !fromHistory && !wc.isSameUser(original.From, senderJID) && !wc.isRevokingAdmin(chatJID, senderJID) {
+		return fmt.Errorf("sender is neither its author nor a group admin")
+	}
+	return nil
+}
+
+// isRevokingAdmin reports whether a sender is a current admin of a group chat, logging failures
+func (wc *WhatsmeowClient) isRevokingAdmin(chatJID, senderJID string) bool {
+	chat, err := waTypes.ParseJID(chatJID)
+	if err != nil || chat.Server != waTypes.GroupServer {
+		return false
+	}
+
+	sender := wc.client.Store.ID.ToNonAD()
+	if senderJID != "self" {
+		if sender, err = waTypes.ParseJID(senderJID); err != nil {
+			return false
+		}
+	}
+
+	admin, err := wc.isGroupAdmin(chat, sender)
+	if err != nil {
+		log.Printf("Failed to check admins of %s: %v", chatJID, err)
+		return false
+	}
+	return admin
+}
+
+// isGroupAdmin reports whether a user is a current admin of a group
+func (wc *WhatsmeowClient) isGroupAdmin(group, user waTypes.JID) (bool, error) {
+	info, err := wc.client.GetGroupInfo(group)
+	if err != nil {
+		return false, fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	// Participants may be listed by phone number or LID, we know both of our own
+	users := map[string]bool{user.User: true}
+	if user.User == wc.client.Store.ID.User && !wc.client.Store.LID.IsEmpty() {
+		users[wc.client.Store.LID.User] = true
+	}
+	for _, participant := range info.Participants {
+		if users[participant.JID.User] || users[participant.PhoneNumber.User] || users[participant.LID.User] {
+			return participant.IsAdmin || participant.IsSuperAdmin, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isSameUser reports whether two stored senders are the same user, ignoring their devices
+func (wc *WhatsmeowClient) isSameUser(a, b string) bool {
+	if wc.isOwnSender(a) || wc.isOwnSender(b) {
+		return wc.isOwnSender(a) && wc.isOwnSender(b)
+	}
+
+	jidA, errA := waTypes.ParseJID(a)
+	jidB, errB := waTypes.ParseJID(b)
+	return errA == nil && errB == nil && jidA.User == jidB.User
+}
+
+// markDeleted marks a stored message as deleted for everyone by the given user and removes its media files
+func (wc *WhatsmeowClient) markDeleted(messageID, deletedBy string, deletedAt int64) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.messageStore.MarkMessageDeleted(ctx, wc.ourJID, messageID, deletedBy, deletedAt); err != nil {
+		log.Printf("Failed to mark message %s as deleted: %v", messageID, err)
+		return
+	}
+	wc.removeMediaFiles(ctx, messageID)
+
+	log.Printf("Message %s deleted by %s", messageID, deletedBy)
+}
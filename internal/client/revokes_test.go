@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/store"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+func newRevokeTestClient() *WhatsmeowClient {
+	ourJID := waTypes.NewJID("10000000000", waTypes.DefaultUserServer)
+	return &WhatsmeowClient{client: &whatsmeow.Client{Store: &store.Device{ID: &ourJID}}}
+}
+
+func TestCheckRevokeRejectsOtherChat(t *testing.T) {
+	wc := newRevokeTestClient()
+	original := &types.Message{
+		ID:   "3EB0ABCDEF",
+		From: "20000000000:3@s.whatsapp.net",
+		Chat: "120363000000000001@g.us",
+	}
+
+	for _, fromHistory := range []bool{false, true} {
+		if err := wc.checkRevoke(original, "120363000000000002@g.us", "20000000000@s.whatsapp.net", fromHistory); err == nil {
+			t.Errorf("expected cross-chat revoke to be rejected (fromHistory=%v)", fromHistory)
+		}
+	}
+}
+
+func TestCheckRevokeAcceptsAuthorInSameChat(t *testing.T) {
+	wc := newRevokeTestClient()
+	original := &types.Message{
+		ID:   "3EB0ABCDEF",
+		From: "20000000000:3@s.whatsapp.net",
+		Chat: "120363000000000001@g.us",
+	}
+
+	for _, fromHistory := range []bool{false, true} {
+		if err := wc.checkRevoke(original, original.Chat, "20000000000@s.whatsapp.net", fromHistory); err != nil {
+			t.Errorf("expected revoke by the author to be accepted (fromHistory=%v): %v", fromHistory, err)
+		}
+	}
+}
+
+func TestCheckRevokeAcceptsOwnRevokeInSameChat(t *testing.T) {
+	wc := newRevokeTestClient()
+	original := &types.Message{
+		ID:   "3EB0ABCDEF",
+		From: "self",
+		Chat: "30000000000@s.whatsapp.net",
+	}
+
+	if err := wc.checkRevoke(original, original.Chat, "self", false); err != nil {
+		t.Errorf("expected own revoke to be accepted: %v", err)
+	}
+	if err := wc.checkRevoke(original, "40000000000@s.whatsapp.net", "self", false); err == nil {
+		t.Error("expected own revoke through another chat to be rejected")
+	}
+}
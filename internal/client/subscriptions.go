@@ -4,6 +4,8 @@ import (
 	"context"
 	"sync"
 
+	"whatsmeow-mcp/internal/types"
+
 	"github.com/mark3labs/mcp-go/server"
 )
 
@@ -119,7 +121,8 @@ func (sm *SubscriptionManager) CleanupSession(sessionID string) {
 }
 
 // NotifyNewMessage sends notification to all subscribed sessions about a new message
-func (sm *SubscriptionManager) NotifyNewMessage(chatJID, messageID, from, text string, timestamp int64) {
+// fromName is the resolved name of the sender and may be empty
+func (sm *SubscriptionManager) NotifyNewMessage(chatJID, messageID, from, fromName, text string, timestamp int64) {
 	subscribedSessions := sm.GetSubscribedSessions(chatJID)
 
 	if len(subscribedSessions) == 0 {
@@ -132,6 +135,7 @@ func (sm *SubscriptionManager) NotifyNewMessage(chatJID, messageID, from, text s
 			"chat":       chatJID,
 			"message_id": messageID,
 			"from":       from,
+			"from_name":  fromName,
 			"text":       text,
 			"timestamp":  timestamp,
 		},
@@ -174,3 +178,143 @@ func (sm *SubscriptionManager) NotifyMessageStatus(chatJID, messageID, status st
 		}
 	}
 }
+
+// NotifyPollVote sends notification to all subscribed sessions about a changed poll vote
+func (sm *SubscriptionManager) NotifyPollVote(chatJID, pollMessageID, voter string, selectedOptions []string, timestamp int64) {
+	subscribedSessions := sm.GetSubscribedSessions(chatJID)
+
+	if len(subscribedSessions) == 0 {
+		return
+	}
+
+	notification := map[string]any{
+		"method": "notifications/message",
+		"params": map[string]any{
+			"chat":             chatJID,
+			"message_id":       pollMessageID,
+			"from":             voter,
+			"type":             "poll_vote",
+			"selected_options": selectedOptions,
+			"timestamp":        timestamp,
+		},
+	}
+
+	for _, sessionID := range subscribedSessions {
+		if sm.mcpServer != nil {
+			ctx := context.Background()
+			sm.mcpServer.SendNotificationToClient(ctx, sessionID, notification)
+		}
+	}
+}
+
+// NotifyJoinRequest sends notification to all subscribed sessions about a new request to join a group
+func (sm *SubscriptionManager) NotifyJoinRequest(groupJID, requester, method string, timestamp int64) {
+	subscribedSessions := sm.GetSubscribedSessions(groupJID)
+
+	if len(subscribedSessions) == 0 {
+		return
+	}
+
+	notification := map[string]any{
+		"method": "notifications/message",
+		"params": map[string]any{
+			"chat":           groupJID,
+			"from":           requester,
+			"type":           "join_request",
+			"request_method": method,
+			"timestamp":      timestamp,
+		},
+	}
+
+	for _, sessionID := range subscribedSessions {
+		if sm.mcpServer != nil {
+			ctx := context.Background()
+			sm.mcpServer.SendNotificationToClient(ctx, sessionID, notification)
+		}
+	}
+}
+
+// NotifyGroupEvent sends notification to all subscribed sessions about a change of a group
+func (sm *SubscriptionManager) NotifyGroupEvent(groupJID, messageID string, event types.GroupEvent, text string, timestamp int64) {
+	subscribedSessions := sm.GetSubscribedSessions(groupJID)
+
+	if len(subscribedSessions) == 0 {
+		return
+	}
+
+	notification := map[string]any{
+		"method": "notifications/message",
+		"params": map[string]any{
+			"chat":         groupJID,
+			"message_id":   messageID,
+			"from":         event.Actor,
+			"type":         "group_event",
+			"action":       event.Action,
+			"participants": event.Participants,
+			"text":         text,
+			"timestamp":    timestamp,
+		},
+	}
+
+	for _, sessionID := range subscribedSessions {
+		if sm.mcpServer != nil {
+			ctx := context.Background()
+			sm.mcpServer.SendNotificationToClient(ctx, sessionID, notification)
+		}
+	}
+}
+
+// NotifyPresence sends notification to all sessions subscribed to a user about their online status
+func (sm *SubscriptionManager) NotifyPresence(presence types.UserPresence) {
+	subscribedSessions := sm.GetSubscribedSessions(presence.JID)
+
+	if len(subscribedSessions) == 0 {
+		return
+	}
+
+	notification := map[string]any{
+		"method": "notifications/message",
+		"params": map[string]any{
+			"chat":      presence.JID,
+			"from":      presence.JID,
+			"type":      "presence",
+			"available": presence.Available,
+			"last_seen": presence.LastSeen,
+			"timestamp": presence.UpdatedAt,
+		},
+	}
+
+	for _, sessionID := range subscribedSessions {
+		if sm.mcpServer != nil {
+			ctx := context.Background()
+			sm.mcpServer.SendNotificationToClient(ctx, sessionID, notification)
+		}
+	}
+}
+
+// NotifyChatPresence sends notification to all subscribed sessions about a user typing, recording or pausing in a chat
+func (sm *SubscriptionManager) NotifyChatPresence(chatState types.ChatState) {
+	subscribedSessions := sm.GetSubscribedSessions(chatState.Chat)
+
+	if len(subscribedSessions) == 0 {
+		return
+	}
+
+	notification := map[string]any{
+		"method": "notifications/message",
+		"params": map[string]any{
+			"chat":      chatState.Chat,
+			"from":      chatState.From,
+			"type":      "chat_presence",
+			"state":     chatState.State,
+			"timestamp": chatState.UpdatedAt,
+		},
+	}
+
+	for _, sessionID := range subscribedSessions {
+		if sm.mcpServer != nil {
+			ctx := context.Background()
+			sm.mcpServer.SendNotificationToClient(ctx, sessionID, notification)
+		}
+	}
+}
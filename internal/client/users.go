@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"whatsmeow-mcp/internal/types"
+
+	"go.mau.fi/whatsmeow"
+	waTypes "go.mau.fi/whatsmeow/types"
+)
+
+// DefaultLookupCacheTTL is how long user info and profile picture lookups are reused by default
+const DefaultLookupCacheTTL = 24 * time.Hour
+
+// MaxProfilePictureSize limits the size of downloaded profile pictures
+const MaxProfilePictureSize = 5 * 1024 * 1024
+
+// ErrProfilePictureHidden is returned when a user has hidden their profile picture from us
+var ErrProfilePictureHidden = whatsmeow.ErrProfilePictureUnauthorized
+
+// Kinds of lookups stored in the lookup cache
+const (
+	lookupUserInfo       = "user_info"
+	lookupProfilePicture = "profile_picture"
+	lookupPicturePreview = "profile_picture_preview"
+)
+
+// SetLookupCacheTTL sets how long user info and profile picture lookups are reused
+func (wc *WhatsmeowClient) SetLookupCacheTTL(ttl time.Duration) {
+	wc.lookupCacheTTL = ttl
+}
+
+// GetUserInfo returns the status text, verified business name, devices and addresses of users
+// Results are cached in the database and reused until they are older than the cache TTL, unless refresh is set
+func (wc *WhatsmeowClient) GetUserInfo(ctx context.Context, jids []string, refresh bool) (*types.UserInfoResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	userJIDs, err := parseParticipantJIDs(jids)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &types.UserInfoResponse{
+		Success: true,
+		Users:   make(map[string]types.UserInfo, len(userJIDs)),
+	}
+
+	// Use cached results where possible and query WhatsApp for the rest
+	var missing []waTypes.JID
+	for _, jid := range userJIDs {
+		var user types.UserInfo
+		if !refresh && wc.cachedLookup(ctx, lookupUserInfo, jid.String(), &user) {
+			response.Users[jid.String()] = user
+			continue
+		}
+		missing = append(missing, jid)
+	}
+
+	if len(missing) > 0 {
+		infos, err := wc.client.GetUserInfo(missing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user info: %w", err)
+		}
+
+		for _, jid := range missing {
+			info, ok := infos[jid]
+			if !ok {
+				continue
+			}
+
+			user := types.UserInfo{
+				JID:       jid.String(),
+				Status:    info.Status,
+				PictureID: info.PictureID,
+				Devices:   make([]string, 0, len(info.Devices)),
+				FetchedAt: time.Now().Unix(),
+			}
+			if info.VerifiedName != nil {
+				user.IsBusiness = true
+				user.VerifiedName = info.VerifiedName.Details.GetVerifiedName()
+			}
+			for _, device := range info.Devices {
+				user.Devices = append(user.Devices, device.String())
+			}
+			wc.fillAddresses(ctx, jid, &user)
+
+			wc.saveLookup(ctx, lookupUserInfo, user.JID, user)
+			response.Users[user.JID] = user
+		}
+	}
+
+	// Names change independently of the lookup, so they are never cached
+	for jid, user := range response.Users {
+		user.Name = wc.contactName(jid)
+		response.Users[jid] = user
+	}
+	response.Count = len(response.Users)
+
+	return response, nil
+}
+
+// GetProfilePictureInfo returns the URL and ID of the profile picture of a user or the photo of a group
+// Results are cached in the database and reused until they are older than the cache TTL, unless refresh is set
+func (wc *WhatsmeowClient) GetProfilePictureInfo(ctx context.Context, jid string, preview, refresh bool) (*types.ProfilePictureResponse, error) {
+	if !wc.IsLoggedIn() {
+		return nil, fmt.Errorf("not logged in")
+	}
+
+	target, err := parseUserOrGroupJID(jid)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := lookupProfilePicture
+	if preview {
+		kind = lookupPicturePreview
+	}
+
+	var response types.ProfilePictureResponse
+	if !refresh && wc.cachedLookup(ctx, kind, target.String(), &response) {
+		return &response, nil
+	}
+
+	params := &whatsmeow.GetProfilePictureParams{Preview: preview}
+	if target.Server == waTypes.GroupServer {
+		if info, err := wc.client.GetGroupInfo(target); err == nil {
+			params.IsCommunity = info.IsParent
+		}
+	}
+
+	picture, err := wc.client.GetProfilePictureInfo(target, params)
+	if err != nil && !errors.Is(err, whatsmeow.ErrProfilePictureNotSet) {
+		return nil, fmt.Errorf("failed to get profile picture: %w", err)
+	}
+
+	response = types.ProfilePictureResponse{
+		Success:   true,
+		JID:       target.String(),
+		FetchedAt: time.Now().Unix(),
+	}
+	if picture != nil {
+		response.HasPicture = true
+		response.PictureID = picture.ID
+		response.URL = picture.URL
+		response.Type = picture.Type
+	}
+
+	wc.saveLookup(ctx, kind, response.JID, response)
+
+	return &response, nil
+}
+
+// DownloadProfilePicture downloads a profile picture from the URL returned by GetProfilePictureInfo
+func (wc *WhatsmeowClient) DownloadProfilePicture(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile picture URL: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download profile picture: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Picture URLs expire after a while, a refreshed lookup returns a new one
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download profile picture: HTTP %d, the picture URL may have expired", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxProfilePictureSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile picture: %w", err)
+	}
+	if len(data) > MaxProfilePictureSize {
+		return nil, fmt.Errorf("profile picture is larger than %d bytes", MaxProfilePictureSize)
+	}
+
+	return data, nil
+}
+
+// fillAddresses sets the phone number and LID of a user from whatsmeow's LID mapping store
+func (wc *WhatsmeowClient) fillAddresses(ctx context.Context, jid waTypes.JID, user *types.UserInfo) {
+	switch jid.Server {
+	case waTypes.DefaultUserServer:
+		user.PhoneNumber = "+" + jid.User
+		if lid, err := wc.client.Store.LIDs.GetLIDForPN(ctx, jid); err == nil && !lid.IsEmpty() {
+			user.LID = lid.String()
+		}
+	case waTypes.HiddenUserServer:
+		user.LID = jid.String()
+		if pn, err := wc.client.Store.LIDs.GetPNForLID(ctx, jid); err == nil && !pn.IsEmpty() {
+			user.PhoneNumber = "+" + pn.User
+		}
+	}
+}
+
+// cachedLookup loads a cached lookup result into dest and marks it as cached
+// Returns false if there is no result younger than the cache TTL
+func (wc *WhatsmeowClient) cachedLookup(ctx context.Context, kind, jid string, dest any) bool {
+	if wc.ourJID == "" || wc.lookupCacheTTL <= 0 {
+		return false
+	}
+
+	fetchedAt, err := wc.messageStore.GetCachedLookup(ctx, wc.ourJID, kind, jid, wc.lookupCacheTTL, dest)
+	if err != nil {
+		log.Printf("Failed to read %s of %s from cache: %v", kind, jid, err)
+		return false
+	}
+	if fetchedAt.IsZero() {
+		return false
+	}
+
+	switch result := dest.(type) {
+	case *types.UserInfo:
+		result.Cached = true
+	case *types.ProfilePictureResponse:
+		result.Cached = true
+	case *types.BusinessProfileResponse:
+		result.Cached = true
+	}
+	return true
+}
+
+// saveLookup stores a lookup result in the cache, logging failures
+func (wc *WhatsmeowClient) saveLookup(ctx context.Context, kind, jid string, value any) {
+	if wc.ourJID == "" || wc.lookupCacheTTL <= 0 {
+		return
+	}
+
+	if err := wc.messageStore.SaveCachedLookup(ctx, wc.ourJID, kind, jid, value); err != nil {
+		log.Printf("Failed to cache %s of %s: %v", kind, jid, err)
+	}
+}
+
+// parseUserOrGroupJID parses a group JID, or a user JID or phone number
+func parseUserOrGroupJID(jid string) (waTypes.JID, error) {
+	if strings.HasSuffix(jid, "@"+waTypes.GroupServer) {
+		return parseGroupJID(jid)
+	}
+
+	jids, err := parseParticipantJIDs([]string{jid})
+	if err != nil {
+		return waTypes.JID{}, err
+	}
+	return jids[0], nil
+}
@@ -6,14 +6,19 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/media"
 	"whatsmeow-mcp/internal/types"
 
 	"github.com/mark3labs/mcp-go/server"
 	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
 	"go.mau.fi/whatsmeow/store/sqlstore"
 	waTypes "go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
@@ -44,6 +49,19 @@ type WhatsmeowClient struct {
 
 	// Subscription manager for MCP notifications
 	subscriptionManager *SubscriptionManager
+
+	// Media store for caching downloaded media files
+	mediaStore *media.Store
+
+	// How long user info and profile picture lookups are reused
+	lookupCacheTTL time.Duration
+
+	// Our presence and the last known presence of other users
+	presence *presenceTracker
+
+	// Whether the labels were fully synced from app state, see syncLabels
+	labelsSynced atomic.Bool
+	labelSync    sync.Mutex
 }
 
 // Ensure WhatsmeowClient implements WhatsAppClientInterface
@@ -72,15 +90,19 @@ func NewWhatsmeowClient(db *sql.DB) (*WhatsmeowClient, error) {
 
 	// Create WhatsApp client
 	client := whatsmeow.NewClient(deviceStore, nil)
+	// Chat settings and labels are stored from app state events, which are also needed when all of it is synced
+	client.EmitAppStateEventsOnFullSync = true
 
 	wc := &WhatsmeowClient{
-		client:       client,
-		container:    container,
-		db:           db,
-		messageStore: database.NewMessageStore(db),
-		qrChan:       make(chan string, 1),
-		connected:    false,
-		loggedIn:     false,
+		client:         client,
+		container:      container,
+		db:             db,
+		messageStore:   database.NewMessageStore(db),
+		qrChan:         make(chan string, 1),
+		connected:      false,
+		loggedIn:       false,
+		lookupCacheTTL: DefaultLookupCacheTTL,
+		presence:       newPresenceTracker(),
 	}
 
 	// Set our JID if device is already paired
@@ -126,8 +148,8 @@ func (wc *WhatsmeowClient) setupEventHandlers() {
 					wc.ourJID = wc.client.Store.ID.String()
 				}
 				log.Printf("Restored session. Logged in as: %s", wc.ourJID)
-				// Request history sync for restored sessions
-				go wc.requestHistorySync()
+				go wc.restorePresence()
+				go wc.syncContacts()
 			}
 		case *events.Disconnected:
 			wc.connected = false
@@ -135,15 +157,55 @@ func (wc *WhatsmeowClient) setupEventHandlers() {
 		case *events.LoggedOut:
 			wc.loggedIn = false
 			wc.ourJID = ""
+			wc.labelsSynced.Store(false)
 			log.Printf("Logged out from WhatsApp")
 		case *events.PairSuccess:
 			wc.loggedIn = true
 			wc.ourJID = wc.client.Store.ID.String()
+			// The phone sends the history sync on its own after pairing
 			log.Printf("Successfully paired with WhatsApp. Our JID: %s", wc.ourJID)
-			// Request history sync after successful pairing
-			go wc.requestHistorySync()
 		case *events.HistorySync:
 			wc.handleHistorySync(v)
+		case *events.GroupInfo:
+			wc.handleGroupInfo(v)
+		case *events.JoinedGroup:
+			wc.handleJoinedGroup(v)
+		case *events.Contact:
+			wc.handleContact(v)
+		case *events.BusinessName:
+			wc.handleBusinessName(v)
+		case *events.Archive:
+			wc.handleArchive(v)
+		case *events.Pin:
+			wc.handlePin(v)
+		case *events.Mute:
+			wc.handleMute(v)
+		case *events.MarkChatAsRead:
+			wc.handleMarkChatAsRead(v)
+		case *events.LabelEdit:
+			wc.handleLabelEdit(v)
+		case *events.LabelAssociationChat:
+			wc.handleLabelAssociationChat(v)
+		case *events.LabelAssociationMessage:
+			wc.handleLabelAssociationMessage(v)
+		case *events.Presence:
+			wc.handlePresence(v)
+		case *events.ChatPresence:
+			wc.handleChatPresence(v)
+		case *events.PushNameSetting:
+			// Presence can only be sent once our push name is known
+			go wc.restorePresence()
+		case *events.AppStateSyncComplete:
+			// The contact list is synced in this patch
+			if v.Name == appstate.WAPatchCriticalUnblockLow {
+				go wc.syncContacts()
+			}
+			// Labels are synced in this patch, their events were handled before this one
+			if v.Name == appstate.WAPatchRegular {
+				wc.labelsSynced.Store(true)
+			}
+		case *events.AppState:
+			// Raw app state changes are handled through their specific events
 		default:
 			// Log other events for debugging
 			log.Printf("Received event: %T", v)
@@ -160,37 +222,85 @@ func (wc *WhatsmeowClient) handleMessage(evt *events.Message) {
 		Timestamp: evt.Info.Timestamp.Unix(),
 	}
 
-	if evt.Message.GetConversation() != "" {
-		message.Text = evt.Message.GetConversation()
-	} else if evt.Message.GetExtendedTextMessage() != nil {
-		message.Text = evt.Message.GetExtendedTextMessage().GetText()
-		if evt.Message.GetExtendedTextMessage().GetContextInfo() != nil &&
-			evt.Message.GetExtendedTextMessage().GetContextInfo().GetStanzaID() != "" {
-			message.QuotedMessageID = evt.Message.GetExtendedTextMessage().GetContextInfo().GetStanzaID()
-		}
+	// Reactions are stored separately and are not messages on their own
+	// The sender is stored without device, so reactions from all devices of a user replace each other
+	senderJID := evt.Info.Sender.ToNonAD().String()
+	if evt.Info.IsFromMe {
+		senderJID = "self"
+	}
+	if reaction := extractReaction(evt.Message, message.Chat, senderJID, message.Timestamp); reaction != nil {
+		wc.storeReaction(*reaction)
+		return
+	}
+
+	// Edits update the stored text of the original message
+	if edit := extractEdit(evt.Message, senderJID, message.Timestamp); edit != nil {
+		wc.applyEdit(*edit)
+		return
+	}
+
+	// Revokes mark the original message as deleted
+	if messageID := extractRevoke(evt.Message); messageID != "" {
+		wc.applyRevoke(message.Chat, messageID, senderJID, message.Timestamp, false)
+		return
+	}
+
+	// Poll votes are decrypted and tallied with their poll
+	if evt.Message.GetPollUpdateMessage() != nil {
+		wc.handlePollVote(evt)
+		return
+	}
+
+	// Remember the sender's push name so messages can be shown with names
+	if !evt.Info.IsFromMe && evt.Info.PushName != "" {
+		wc.savePushName(evt.Info.Sender, evt.Info.SenderAlt, evt.Info.PushName)
 	}
 
+	// Extract text, media metadata and download info
+	mediaRecord := extractMessageContent(evt.Message, &message)
+
 	// Save message to database
 	if wc.ourJID != "" {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
-		if err := wc.messageStore.SaveMessage(ctx, message, wc.ourJID); err != nil {
+		if err := wc.storeMessage(ctx, message, mediaRecord); err != nil {
 			log.Printf("Failed to save message to database: %v", err)
 		}
+		wc.storePoll(evt.Message, message)
+		wc.refreshChat(message.Chat)
 	}
 
 	// Send MCP notification to subscribed sessions
 	if wc.subscriptionManager != nil {
+		if !evt.Info.IsFromMe {
+			message.FromName = wc.contactName(message.From)
+		}
 		wc.subscriptionManager.NotifyNewMessage(
 			message.Chat,
 			message.ID,
 			message.From,
+			message.FromName,
 			message.Text,
 			message.Timestamp,
 		)
 	}
 
-	log.Printf("Received message from %s: %s", message.From, message.Text)
+	log.Printf("Received %s message from %s: %s", message.MessageType, message.From, message.Text)
+}
+
+// storeMessage saves a message and, for media messages, its download info to the database
+func (wc *WhatsmeowClient) storeMessage(ctx context.Context, message types.Message, mediaRecord *database.MediaRecord) error {
+	if err := wc.messageStore.SaveMessage(ctx, message, wc.ourJID); err != nil {
+		return err
+	}
+
+	if mediaRecord != nil {
+		if err := wc.messageStore.SaveMedia(ctx, *mediaRecord, wc.ourJID); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // handleReceipt processes delivery and read receipts
@@ -213,6 +323,8 @@ func (wc *WhatsmeowClient) handleReceipt(evt *events.Receipt) {
 		log.Printf("Message %s read by us on another device", evt.MessageIDs[0])
 		// Это событие означает, что мы прочитали сообщение на другом устройстве
 		// Обновляем статус прочтения в базе данных
+		// Reading on another device also reads the messages counted as unread in history sync
+		wc.clearHistoryUnreadCount(evt.Chat.String())
 		wc.updateMessageReadStatus(evt.MessageIDs, evt.Chat.String(), true)
 	default:
 		log.Printf("Unknown receipt type: %v for messages %v", evt.Type, evt.MessageIDs)
@@ -238,6 +350,8 @@ func (wc *WhatsmeowClient) updateMessageReadStatus(messageIDs []string, chatJID
 			log.Printf("Updated read status for message %s to %v", messageID, isRead)
 		}
 	}
+
+	wc.refreshChat(chatJID)
 }
 
 // updateMessageDeliveryStatus updates the delivery status of messages in the database
@@ -320,6 +434,11 @@ func (wc *WhatsmeowClient) SetSubscriptionManager(sm *SubscriptionManager) {
 	wc.subscriptionManager = sm
 }
 
+// SetMediaStore sets the media store used to cache downloaded media
+func (wc *WhatsmeowClient) SetMediaStore(ms *media.Store) {
+	wc.mediaStore = ms
+}
+
 // GetSubscriptionManager returns the subscription manager
 func (wc *WhatsmeowClient) GetSubscriptionManager() *SubscriptionManager {
 	return wc.subscriptionManager
@@ -355,22 +474,13 @@ func (wc *WhatsmeowClient) SendMessage(ctx context.Context, to, text, quotedMess
 	}
 
 	// Send message
-	resp, err := wc.client.SendMessage(context.Background(), jid, msg)
+	resp, err := wc.client.SendMessage(ctx, jid, msg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send message: %w", err)
 	}
 
 	// Auto-subscribe session to this chat (extract sessionID from context)
-	if wc.subscriptionManager != nil {
-		session := server.ClientSessionFromContext(ctx)
-		if session != nil {
-			sessionID := session.SessionID()
-			isNew := wc.subscriptionManager.Subscribe(sessionID, to)
-			if isNew {
-				log.Printf("Auto-subscribed session %s to chat %s", sessionID, to)
-			}
-		}
-	}
+	wc.autoSubscribe(ctx, to)
 
 	// Create response
 	response := &types.MessageResponse{
@@ -391,20 +501,72 @@ func (wc *WhatsmeowClient) SendMessage(ctx context.Context, to, text, quotedMess
 		Timestamp:       resp.Timestamp.Unix(),
 		Chat:            to,
 		QuotedMessageID: quotedMessageID,
+		MessageType:     types.MessageTypeText,
 	}
 
 	// Save sent message to database
-	if wc.ourJID != "" {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		if err := wc.messageStore.SaveMessage(ctx, message, wc.ourJID); err != nil {
-			log.Printf("Failed to save sent message to database: %v", err)
-		}
-	}
+	wc.saveSentMessage(message, nil)
 
 	return response, nil
 }
 
+// autoSubscribe subscribes the MCP session found in ctx to notifications from the given chat
+func (wc *WhatsmeowClient) autoSubscribe(ctx context.Context, chatJID string) {
+	if wc.subscriptionManager == nil {
+		return
+	}
+
+	session := server.ClientSessionFromContext(ctx)
+	if session == nil {
+		return
+	}
+
+	sessionID := session.SessionID()
+	isNew := wc.subscriptionManager.Subscribe(sessionID, chatJID)
+	if isNew {
+		log.Printf("Auto-subscribed session %s to chat %s", sessionID, chatJID)
+	}
+}
+
+// sendAndSave sends a prepared message, subscribes the caller's session to the chat
+// and stores the message with the content extracted from it
+// The send uses ctx like the upload before it, so a cancelled request does not send the message
+func (wc *WhatsmeowClient) sendAndSave(ctx context.Context, jid waTypes.JID, to string, msg *waProto.Message) (whatsmeow.SendResponse, error) {
+	resp, err := wc.client.SendMessage(ctx, jid, msg)
+	if err != nil {
+		return resp, err
+	}
+
+	// Auto-subscribe session to this chat
+	wc.autoSubscribe(ctx, to)
+
+	message := types.Message{
+		ID:        resp.ID,
+		From:      "self",
+		To:        to,
+		Timestamp: resp.Timestamp.Unix(),
+		Chat:      to,
+	}
+	mediaRecord := extractMessageContent(msg, &message)
+	wc.saveSentMessage(message, mediaRecord)
+
+	return resp, nil
+}
+
+// saveSentMessage stores an outgoing message and its media download info in the database
+func (wc *WhatsmeowClient) saveSentMessage(message types.Message, mediaRecord *database.MediaRecord) {
+	if wc.ourJID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wc.storeMessage(ctx, message, mediaRecord); err != nil {
+		log.Printf("Failed to save sent message to database: %v", err)
+	}
+	wc.refreshChat(message.Chat)
+}
+
 // IsOnWhatsApp checks if phone numbers are registered on WhatsApp
 func (wc *WhatsmeowClient) IsOnWhatsApp(phones []string) ([]types.WhatsAppCheckResult, error) {
 	if !wc.IsLoggedIn() {
@@ -441,7 +603,8 @@ func (wc *WhatsmeowClient) IsOnWhatsApp(phones []string) ([]types.WhatsAppCheckR
 }
 
 // GetChatMessages returns messages for a specific chat from database
-func (wc *WhatsmeowClient) GetChatMessages(chatJID string, count int, beforeMessageID string) []types.Message {
+// The content of deleted messages is only returned if includeDeleted is set
+func (wc *WhatsmeowClient) GetChatMessages(chatJID string, count int, beforeMessageID string, includeDeleted bool) []types.Message {
 	if wc.ourJID == "" {
 		return []types.Message{}
 	}
@@ -449,7 +612,7 @@ func (wc *WhatsmeowClient) GetChatMessages(chatJID string, count int, beforeMess
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	chatMessages, err := wc.messageStore.GetChatMessages(ctx, wc.ourJID, chatJID, count, beforeMessageID)
+	chatMessages, err := wc.messageStore.GetChatMessages(ctx, wc.ourJID, chatJID, count, beforeMessageID, includeDeleted)
 	if err != nil {
 		log.Printf("Failed to get chat messages from database: %v", err)
 		return []types.Message{}
@@ -518,32 +681,7 @@ func (wc *WhatsmeowClient) AddMessage(message types.Message) {
 	if err := wc.messageStore.SaveMessage(ctx, message, wc.ourJID); err != nil {
 		log.Printf("Failed to add message to database: %v", err)
 	}
-}
-
-// requestHistorySync requests message history from WhatsApp servers
-func (wc *WhatsmeowClient) requestHistorySync() {
-	if !wc.IsLoggedIn() {
-		log.Printf("Cannot request history sync: not logged in")
-		return
-	}
-
-	// Wait a bit for the connection to stabilize
-	time.Sleep(3 * time.Second)
-
-	log.Printf("Requesting message history sync...")
-
-	// Send presence to trigger history sync
-	err := wc.client.SendPresence(waTypes.PresenceAvailable)
-	if err != nil {
-		log.Printf("Failed to send presence for history sync: %v", err)
-		return
-	}
-
-	// Additionally, try to fetch some recent conversations
-	// This can help trigger history sync in some cases
-	time.Sleep(2 * time.Second)
-
-	log.Printf("History sync request completed. Waiting for HistorySync events...")
+	wc.refreshChat(message.Chat)
 }
 
 // handleHistorySync processes history sync events from WhatsApp
@@ -558,12 +696,21 @@ func (wc *WhatsmeowClient) handleHistorySync(evt *events.HistorySync) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Store the push names of the senders in this history sync
+	wc.saveHistoryPushNames(evt.Data.GetPushnames())
+
 	messageCount := 0
 
+	// Only the first chunks of history sync hold the current unread counts, older backfill is not applied
+	syncType := evt.Data.GetSyncType()
+	currentState := syncType == waHistorySync.HistorySync_INITIAL_BOOTSTRAP || syncType == waHistorySync.HistorySync_RECENT
+
 	// Process each conversation in the history sync
 	for _, conversation := range evt.Data.GetConversations() {
 		chatJID := conversation.GetId()
 		log.Printf("Processing conversation: %s", chatJID)
+		wc.saveHistoryChat(conversation)
+		lastMessageAt := int64(conversation.GetConversationTimestamp())
 
 		// Process messages in this conversation
 		for _, historyMsg := range conversation.GetMessages() {
@@ -577,28 +724,69 @@ func (wc *WhatsmeowClient) handleHistorySync(evt *events.HistorySync) {
 			}
 
 			// Convert WhatsApp message to our internal format
-			message := wc.convertHistoryMessage(webMsg, chatJID)
+			message, mediaRecord := wc.convertHistoryMessage(webMsg, chatJID)
 			if message.ID == "" {
 				continue
 			}
+			lastMessageAt = max(lastMessageAt, message.Timestamp)
+
+			// Store reactions to this message, and reaction messages themselves only as reactions
+			for _, reaction := range historyReactions(webMsg, chatJID) {
+				if err := wc.messageStore.SaveReaction(ctx, reaction, wc.ourJID); err != nil {
+					log.Printf("Failed to save history reaction: %v", err)
+				}
+			}
+			if reaction := extractReaction(webMsg.GetMessage(), chatJID, message.From, message.Timestamp); reaction != nil {
+				if err := wc.messageStore.SaveReaction(ctx, *reaction, wc.ourJID); err != nil {
+					log.Printf("Failed to save history reaction: %v", err)
+				}
+				continue
+			}
+			if edit := extractEdit(webMsg.GetMessage(), message.From, message.Timestamp); edit != nil {
+				wc.applyEdit(*edit)
+				continue
+			}
+			if messageID := extractRevoke(webMsg.GetMessage()); messageID != "" {
+				wc.applyRevoke(chatJID, messageID, message.From, message.Timestamp, true)
+				continue
+			}
 
 			// Save to database
-			if err := wc.messageStore.SaveMessage(ctx, message, wc.ourJID); err != nil {
+			if err := wc.storeMessage(ctx, message, mediaRecord); err != nil {
 				log.Printf("Failed to save history message: %v", err)
 				continue
 			}
 
+			// Store poll definitions and the already decrypted votes of history polls
+			wc.storePoll(webMsg.GetMessage(), message)
+			for _, update := range webMsg.GetPollUpdates() {
+				voterJID := historySender(update.GetPollUpdateMessageKey())
+				timestamp := update.GetSenderTimestampMS() / 1000
+				wc.storePollVote(ctx, chatJID, message.ID, voterJID, update.GetVote().GetSelectedOptions(), timestamp, true)
+			}
+
 			messageCount++
 		}
+
+		// Messages from history are stored as unread, the chat shows the unread count of the phone instead
+		if currentState {
+			if err := wc.messageStore.SaveHistoryUnreadCount(ctx, wc.ourJID, chatJID, int(conversation.GetUnreadCount()), lastMessageAt); err != nil {
+				log.Printf("Failed to save unread count of %s: %v", chatJID, err)
+			}
+		}
+
+		// Refresh the chat list once for the whole conversation
+		wc.refreshChat(chatJID)
 	}
 
 	log.Printf("Successfully processed %d messages from history sync", messageCount)
 }
 
 // convertHistoryMessage converts a WhatsApp history message to our internal format
-func (wc *WhatsmeowClient) convertHistoryMessage(webMsg *waProto.WebMessageInfo, chatJID string) types.Message {
+// Returns the media download info for media messages
+func (wc *WhatsmeowClient) convertHistoryMessage(webMsg *waProto.WebMessageInfo, chatJID string) (types.Message, *database.MediaRecord) {
 	if webMsg.GetKey() == nil {
-		return types.Message{}
+		return types.Message{}, nil
 	}
 
 	key := webMsg.GetKey()
@@ -619,21 +807,10 @@ func (wc *WhatsmeowClient) convertHistoryMessage(webMsg *waProto.WebMessageInfo,
 		}
 	}
 
-	// Extract message text
-	if webMsg.GetMessage() != nil {
-		msg := webMsg.GetMessage()
-		if msg.GetConversation() != "" {
-			message.Text = msg.GetConversation()
-		} else if msg.GetExtendedTextMessage() != nil {
-			message.Text = msg.GetExtendedTextMessage().GetText()
-			if msg.GetExtendedTextMessage().GetContextInfo() != nil &&
-				msg.GetExtendedTextMessage().GetContextInfo().GetStanzaId() != "" {
-				message.QuotedMessageID = msg.GetExtendedTextMessage().GetContextInfo().GetStanzaId()
-			}
-		}
-	}
+	// Extract message text and media
+	mediaRecord := extractMessageContent(webMsg.GetMessage(), &message)
 
-	return message
+	return message, mediaRecord
 }
 
 // TestAddMessage is a helper function to add test messages for debugging
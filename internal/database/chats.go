@@ -0,0 +1,254 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/lib/pq"
+)
+
+// ChatInfo holds the settings of a chat known from WhatsApp rather than from stored messages
+// An empty name and nil fields leave the stored values unchanged
+type ChatInfo struct {
+	JID          string
+	Name         string
+	Archived     *bool
+	Pinned       *bool
+	MutedUntil   *int64
+	MarkedUnread *bool
+}
+
+// visibleMessage matches the messages shown in chat history, skipping empty messages of unsupported types
+const visibleMessage = `(message_type != 'text' OR TRIM(message_text) != '')`
+
+// chatName picks the name of a chat: the name from WhatsApp, then the contact name for direct chats
+const chatName = `COALESCE(NULLIF(chats.name, ''), ` + contactName + `)`
+
+// chatColumns lists the columns selected for a chat row, in scanChat order
+// The query must join contacts for the name and the last message as messages
+const chatColumns = `chats.jid, chats.chat_type, ` + chatName + `, COALESCE(chats.last_message_id, ''), chats.last_message_at,
+	COALESCE(CASE WHEN messages.deleted_at IS NULL THEN messages.message_text END, ''),
+	chats.unread_count, chats.marked_unread, chats.is_archived, chats.is_pinned, chats.muted_until,
+	chats.muted_until = -1 OR chats.muted_until > EXTRACT(EPOCH FROM NOW())::BIGINT, ` + chatLabelIDs
+
+// chatJoins joins the contact and the last message of a chat
+const chatJoins = `
+	LEFT JOIN contacts ON contacts.our_jid = chats.our_jid AND contacts.jid = chats.jid
+	LEFT JOIN messages ON messages.our_jid = chats.our_jid AND messages.id = chats.last_message_id`
+
+// chatOrders maps the supported sort orders of the chat list to their ORDER BY clause
+var chatOrders = map[string]string{
+	"recent": `chats.is_pinned DESC, chats.last_message_at DESC, chats.jid`,
+	"unread": `chats.unread_count DESC, chats.marked_unread DESC, chats.last_message_at DESC, chats.jid`,
+	"name":   chatName + ` = '', LOWER(` + chatName + `), chats.jid`,
+}
+
+// RefreshChat updates the last message and unread count of a chat from its stored messages, creating the chat if needed
+// The unread count is the one the phone reported in history sync plus the unread messages received after it
+func (ms *MessageStore) RefreshChat(ctx context.Context, ourJID, chatJID string) error {
+	query := `
+		INSERT INTO chats (our_jid, jid, chat_type, last_message_id, last_message_at, unread_count)
+		SELECT $1, $2, $3, last.id, COALESCE(last.timestamp, 0), COALESCE(history.history_unread_count, 0) + (
+			SELECT COUNT(*) FROM messages
+			WHERE our_jid = $1 AND chat_jid = $2 AND is_read = false AND ` + visibleMessage + `
+				AND timestamp > COALESCE(history.history_synced_at, 0)
+		)
+		FROM (SELECT 1) AS chat
+		LEFT JOIN chats AS history ON history.our_jid = $1 AND history.jid = $2
+		LEFT JOIN LATERAL (
+			SELECT id, timestamp FROM messages
+			WHERE our_jid = $1 AND chat_jid = $2 AND ` + visibleMessage + `
+			ORDER BY timestamp DESC, id DESC
+			LIMIT 1
+		) AS last ON true
+		ON CONFLICT (our_jid, jid) DO UPDATE SET
+			last_message_id = EXCLUDED.last_message_id,
+			last_message_at = EXCLUDED.last_message_at,
+			unread_count = EXCLUDED.unread_count,
+			updated_at = NOW()
+	`
+
+	if _, err := ms.db.ExecContext(ctx, query, ourJID, chatJID, chatType(chatJID)); err != nil {
+		return fmt.Errorf("failed to refresh chat: %w", err)
+	}
+
+	return nil
+}
+
+// SaveChatInfo stores the name and settings of a chat, creating the chat if needed
+func (ms *MessageStore) SaveChatInfo(ctx context.Context, info ChatInfo, ourJID string) error {
+	query := `
+		INSERT INTO chats (our_jid, jid, chat_type, name, is_archived, is_pinned, muted_until, marked_unread)
+		VALUES ($1, $2, $3, $4, COALESCE($5, false), COALESCE($6, false), COALESCE($7, 0), COALESCE($8, false))
+		ON CONFLICT (our_jid, jid) DO UPDATE SET
+			name = COALESCE(NULLIF(EXCLUDED.name, ''), chats.name),
+			is_archived = COALESCE($5, chats.is_archived),
+			is_pinned = COALESCE($6, chats.is_pinned),
+			muted_until = COALESCE($7, chats.muted_until),
+			marked_unread = COALESCE($8, chats.marked_unread),
+			updated_at = NOW()
+	`
+
+	var archived, pinned, markedUnread sql.NullBool
+	var mutedUntil sql.NullInt64
+	if info.Archived != nil {
+		archived = sql.NullBool{Bool: *info.Archived, Valid: true}
+	}
+	if info.Pinned != nil {
+		pinned = sql.NullBool{Bool: *info.Pinned, Valid: true}
+	}
+	if info.MutedUntil != nil {
+		mutedUntil = sql.NullInt64{Int64: *info.MutedUntil, Valid: true}
+	}
+	if info.MarkedUnread != nil {
+		markedUnread = sql.NullBool{Bool: *info.MarkedUnread, Valid: true}
+	}
+
+	_, err := ms.db.ExecContext(ctx, query, ourJID, info.JID, chatType(info.JID), info.Name, archived, pinned, mutedUntil, markedUnread)
+	if err != nil {
+		return fmt.Errorf("failed to save chat info: %w", err)
+	}
+
+	return nil
+}
+
+// SaveHistoryUnreadCount stores the unread count the phone reported for a chat in history sync
+// History sync delivers all messages as unread, so the chat counts this instead of its unread messages up to syncedAt.
+// Counts older than the stored one are ignored. Messages are left unchanged.
+func (ms *MessageStore) SaveHistoryUnreadCount(ctx context.Context, ourJID, chatJID string, unreadCount int, syncedAt int64) error {
+	query := `
+		INSERT INTO chats (our_jid, jid, chat_type, history_unread_count, history_synced_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, jid) DO UPDATE SET
+			history_unread_count = EXCLUDED.history_unread_count,
+			history_synced_at = EXCLUDED.history_synced_at,
+			updated_at = NOW()
+		WHERE chats.history_synced_at <= EXCLUDED.history_synced_at
+	`
+
+	if _, err := ms.db.ExecContext(ctx, query, ourJID, chatJID, chatType(chatJID), unreadCount, syncedAt); err != nil {
+		return fmt.Errorf("failed to save history unread count: %w", err)
+	}
+
+	return nil
+}
+
+// ClearHistoryUnreadCount clears the unread count reported in history sync once a chat is read
+func (ms *MessageStore) ClearHistoryUnreadCount(ctx context.Context, ourJID, chatJID string) error {
+	query := `UPDATE chats SET history_unread_count = 0, updated_at = NOW() WHERE our_jid = $1 AND jid = $2 AND history_unread_count != 0`
+
+	if _, err := ms.db.ExecContext(ctx, query, ourJID, chatJID); err != nil {
+		return fmt.Errorf("failed to clear history unread count: %w", err)
+	}
+
+	return nil
+}
+
+// chatFilter matches the chats of our_jid $1, optionally of type $2 and with label $3
+const chatFilter = `chats.our_jid = $1 AND ($2 = '' OR chats.chat_type = $2) AND ($3 = '' OR EXISTS (
+	SELECT 1 FROM label_associations
+	WHERE label_associations.our_jid = chats.our_jid AND label_associations.chat_jid = chats.jid
+		AND label_associations.label_id = $3 AND label_associations.message_id = ''
+))`
+
+// GetChats lists chats, optionally of one type or with one label, in the given sort order (recent, unread or name)
+// Returns the chats of the requested page and the total number of matching chats
+func (ms *MessageStore) GetChats(ctx context.Context, ourJID, chatType, labelID, sortBy string, count, offset int) ([]types.Chat, int, error) {
+	order, ok := chatOrders[sortBy]
+	if !ok {
+		order = chatOrders["recent"]
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM chats WHERE ` + chatFilter
+	if err := ms.db.QueryRowContext(ctx, countQuery, ourJID, chatType, labelID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count chats: %w", err)
+	}
+
+	query := `
+		SELECT ` + chatColumns + `
+		FROM chats` + chatJoins + `
+		WHERE ` + chatFilter + `
+		ORDER BY ` + order + `
+		LIMIT $4 OFFSET $5
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, chatType, labelID, count, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query chats: %w", err)
+	}
+	defer rows.Close()
+
+	chats := []types.Chat{}
+	for rows.Next() {
+		chat, err := scanChat(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		chats = append(chats, *chat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating chats: %w", err)
+	}
+
+	return chats, total, nil
+}
+
+// GetChat returns a single chat, or nil if it is not known
+func (ms *MessageStore) GetChat(ctx context.Context, ourJID, chatJID string) (*types.Chat, error) {
+	query := `
+		SELECT ` + chatColumns + `
+		FROM chats` + chatJoins + `
+		WHERE chats.our_jid = $1 AND chats.jid = $2
+	`
+
+	chat, err := scanChat(ms.db.QueryRowContext(ctx, query, ourJID, chatJID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return chat, err
+}
+
+// scanChat scans a row selected with chatColumns into a chat
+func scanChat(row interface{ Scan(dest ...any) error }) (*types.Chat, error) {
+	var chat types.Chat
+	if err := row.Scan(
+		&chat.JID,
+		&chat.Type,
+		&chat.Name,
+		&chat.LastMessageID,
+		&chat.LastMessageAt,
+		&chat.LastMessageText,
+		&chat.UnreadCount,
+		&chat.MarkedUnread,
+		&chat.IsArchived,
+		&chat.IsPinned,
+		&chat.MutedUntil,
+		&chat.IsMuted,
+		pq.Array(&chat.LabelIDs),
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan chat: %w", err)
+	}
+	// An expired mute is no longer of interest
+	if !chat.IsMuted {
+		chat.MutedUntil = 0
+	}
+	return &chat, nil
+}
+
+// chatType derives the type of a chat from the server part of its JID
+func chatType(jid string) string {
+	switch {
+	case strings.HasSuffix(jid, "@g.us"):
+		return types.ChatTypeGroup
+	case strings.HasSuffix(jid, "@broadcast"), strings.HasSuffix(jid, "@newsletter"):
+		return types.ChatTypeBroadcast
+	}
+	return types.ChatTypeDirect
+}
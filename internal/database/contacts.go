@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/lib/pq"
+)
+
+// Contact holds the known names of a WhatsApp user, empty fields leave stored names unchanged
+type Contact struct {
+	JID          string
+	FirstName    string
+	FullName     string
+	PushName     string
+	BusinessName string
+}
+
+// contactName picks the best known name of a contact: address book name, verified business name, then push name
+const contactName = `COALESCE(NULLIF(full_name, ''), NULLIF(first_name, ''), NULLIF(business_name, ''), NULLIF(push_name, ''), '')`
+
+// SaveContact stores the names of a contact, keeping stored names for fields that are empty
+func (ms *MessageStore) SaveContact(ctx context.Context, contact Contact, ourJID string) error {
+	query := `
+		INSERT INTO contacts (our_jid, jid, first_name, full_name, push_name, business_name)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, jid) DO UPDATE SET
+			first_name = COALESCE(NULLIF(EXCLUDED.first_name, ''), contacts.first_name),
+			full_name = COALESCE(NULLIF(EXCLUDED.full_name, ''), contacts.full_name),
+			push_name = COALESCE(NULLIF(EXCLUDED.push_name, ''), contacts.push_name),
+			business_name = COALESCE(NULLIF(EXCLUDED.business_name, ''), contacts.business_name),
+			updated_at = NOW()
+		WHERE (contacts.first_name, contacts.full_name, contacts.push_name, contacts.business_name) IS DISTINCT FROM (
+			COALESCE(NULLIF(EXCLUDED.first_name, ''), contacts.first_name),
+			COALESCE(NULLIF(EXCLUDED.full_name, ''), contacts.full_name),
+			COALESCE(NULLIF(EXCLUDED.push_name, ''), contacts.push_name),
+			COALESCE(NULLIF(EXCLUDED.business_name, ''), contacts.business_name)
+		)
+	`
+
+	_, err := ms.db.ExecContext(ctx, query,
+		ourJID,
+		contactJID(contact.JID),
+		contact.FirstName,
+		contact.FullName,
+		contact.PushName,
+		contact.BusinessName,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save contact: %w", err)
+	}
+
+	return nil
+}
+
+// GetContacts lists contacts ordered by name, optionally filtered by a name or phone number search
+func (ms *MessageStore) GetContacts(ctx context.Context, ourJID, search string, count int) ([]types.Contact, error) {
+	query := `
+		SELECT jid, ` + contactName + `, first_name, full_name, push_name, business_name
+		FROM contacts
+		WHERE our_jid = $1 AND ($2 = '' OR ` + contactName + ` ILIKE '%' || $2 || '%' ESCAPE '\' OR push_name ILIKE '%' || $2 || '%' ESCAPE '\'
+			OR ($3 != '' AND jid LIKE '%' || $3 || '%@%' ESCAPE '\'))
+		ORDER BY ` + contactName + ` = '', LOWER(` + contactName + `), jid
+		LIMIT $4
+	`
+
+	search = strings.TrimSpace(search)
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, escapeLike(search), escapeLike(digits(search)), count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contacts: %w", err)
+	}
+	defer rows.Close()
+
+	contacts := []types.Contact{}
+	for rows.Next() {
+		var contact types.Contact
+		if err := rows.Scan(&contact.JID, &contact.Name, &contact.FirstName, &contact.FullName, &contact.PushName, &contact.BusinessName); err != nil {
+			return nil, fmt.Errorf("failed to scan contact: %w", err)
+		}
+		if strings.HasSuffix(contact.JID, "@s.whatsapp.net") {
+			contact.PhoneNumber = "+" + strings.TrimSuffix(contact.JID, "@s.whatsapp.net")
+		}
+		contacts = append(contacts, contact)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating contacts: %w", err)
+	}
+
+	return contacts, nil
+}
+
+// GetContactName returns the best known name of a user, or an empty string if there is none
+func (ms *MessageStore) GetContactName(ctx context.Context, ourJID, jid string) (string, error) {
+	names, err := ms.contactNames(ctx, ourJID, []string{contactJID(jid)})
+	if err != nil {
+		return "", err
+	}
+	return names[contactJID(jid)], nil
+}
+
+// attachContactNames sets the resolved sender name of the given messages
+func (ms *MessageStore) attachContactNames(ctx context.Context, ourJID string, messages []types.Message) error {
+	var jids []string
+	for _, msg := range messages {
+		if msg.From != "self" {
+			jids = append(jids, contactJID(msg.From))
+		}
+	}
+	if len(jids) == 0 {
+		return nil
+	}
+
+	names, err := ms.contactNames(ctx, ourJID, jids)
+	if err != nil {
+		return err
+	}
+
+	for i := range messages {
+		if messages[i].From != "self" {
+			messages[i].FromName = names[contactJID(messages[i].From)]
+		}
+	}
+
+	return nil
+}
+
+// contactNames looks up the best known names of the given users, keyed by JID
+func (ms *MessageStore) contactNames(ctx context.Context, ourJID string, jids []string) (map[string]string, error) {
+	query := `
+		SELECT jid, ` + contactName + `
+		FROM contacts
+		WHERE our_jid = $1 AND jid = ANY($2)
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, pq.Array(jids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query contact names: %w", err)
+	}
+	defer rows.Close()
+
+	names := make(map[string]string, len(jids))
+	for rows.Next() {
+		var jid, name string
+		if err := rows.Scan(&jid, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan contact name: %w", err)
+		}
+		names[jid] = name
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating contact names: %w", err)
+	}
+
+	return names, nil
+}
+
+// contactJID strips the device part from a user JID (e.g. "123:4@s.whatsapp.net"), contacts are stored per user
+func contactJID(jid string) string {
+	user, server, found := strings.Cut(jid, "@")
+	if !found {
+		return jid
+	}
+	user, _, _ = strings.Cut(user, ":")
+	return user + "@" + server
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, so a search term matches them literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// digits returns only the digits of a search term, used to match phone numbers in any format
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,200 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/lib/pq"
+)
+
+// labelColumns lists the columns selected for a label row, in scanLabel order
+const labelColumns = `labels.id, labels.name, labels.color, labels.predefined_id,
+	(SELECT COUNT(*) FROM label_associations a WHERE a.our_jid = labels.our_jid AND a.label_id = labels.id AND a.message_id = ''),
+	(SELECT COUNT(*) FROM label_associations a WHERE a.our_jid = labels.our_jid AND a.label_id = labels.id AND a.message_id != '')`
+
+// chatLabelIDs selects the IDs of the labels of a chat as an array
+const chatLabelIDs = `ARRAY(
+	SELECT label_id FROM label_associations
+	WHERE label_associations.our_jid = chats.our_jid AND label_associations.chat_jid = chats.jid AND label_associations.message_id = ''
+	ORDER BY label_id
+)`
+
+// SaveLabel stores a new label or updates its name and color, restoring it if it was deleted
+func (ms *MessageStore) SaveLabel(ctx context.Context, label types.Label, ourJID string) error {
+	query := `
+		INSERT INTO labels (our_jid, id, name, color, predefined_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, id) DO UPDATE SET
+			name = EXCLUDED.name,
+			color = EXCLUDED.color,
+			predefined_id = EXCLUDED.predefined_id,
+			deleted = FALSE,
+			updated_at = NOW()
+	`
+
+	_, err := ms.db.ExecContext(ctx, query, ourJID, label.ID, label.Name, label.Color, label.PredefinedID)
+	if err != nil {
+		return fmt.Errorf("failed to save label: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLabel marks a label as deleted and removes its associations with chats and messages
+// The label row is kept so NextLabelID never allocates its ID again
+func (ms *MessageStore) DeleteLabel(ctx context.Context, ourJID, labelID string) error {
+	tx, err := ms.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM label_associations WHERE our_jid = $1 AND label_id = $2`, ourJID, labelID); err != nil {
+		return fmt.Errorf("failed to delete label associations: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE labels SET deleted = TRUE, updated_at = NOW() WHERE our_jid = $1 AND id = $2`, ourJID, labelID); err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetLabels lists all labels with the number of chats and messages they are on
+func (ms *MessageStore) GetLabels(ctx context.Context, ourJID string) ([]types.Label, error) {
+	query := `
+		SELECT ` + labelColumns + `
+		FROM labels
+		WHERE labels.our_jid = $1 AND NOT labels.deleted
+		ORDER BY LOWER(labels.name), labels.id
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query labels: %w", err)
+	}
+	defer rows.Close()
+
+	labels := []types.Label{}
+	for rows.Next() {
+		label, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, *label)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+// GetLabel returns a single label, or nil if it is not known or deleted
+func (ms *MessageStore) GetLabel(ctx context.Context, ourJID, labelID string) (*types.Label, error) {
+	query := `
+		SELECT ` + labelColumns + `
+		FROM labels
+		WHERE labels.our_jid = $1 AND labels.id = $2 AND NOT labels.deleted
+	`
+
+	label, err := scanLabel(ms.db.QueryRowContext(ctx, query, ourJID, labelID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return label, err
+}
+
+// NextLabelID returns an unused label ID. WhatsApp label IDs are increasing numbers
+// Deleted labels are included, their IDs are never reused
+func (ms *MessageStore) NextLabelID(ctx context.Context, ourJID string) (string, error) {
+	query := `SELECT COALESCE(MAX(id::BIGINT), 0) + 1 FROM labels WHERE our_jid = $1 AND id ~ '^[0-9]+$'`
+
+	var next int64
+	if err := ms.db.QueryRowContext(ctx, query, ourJID).Scan(&next); err != nil {
+		return "", fmt.Errorf("failed to get next label ID: %w", err)
+	}
+
+	return fmt.Sprint(next), nil
+}
+
+// SetLabelAssociation adds a label to or removes it from a chat, or from a message if messageID is set
+func (ms *MessageStore) SetLabelAssociation(ctx context.Context, ourJID, labelID, chatJID, messageID string, labeled bool) error {
+	query := `DELETE FROM label_associations WHERE our_jid = $1 AND label_id = $2 AND chat_jid = $3 AND message_id = $4`
+	if labeled {
+		query = `
+			INSERT INTO label_associations (our_jid, label_id, chat_jid, message_id)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING
+		`
+	}
+
+	if _, err := ms.db.ExecContext(ctx, query, ourJID, labelID, chatJID, messageID); err != nil {
+		return fmt.Errorf("failed to save label association: %w", err)
+	}
+
+	return nil
+}
+
+// attachLabels loads the label IDs of the given messages
+func (ms *MessageStore) attachLabels(ctx context.Context, ourJID string, messages []types.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(messages))
+	index := make(map[string]int, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+		index[msg.ID] = i
+	}
+
+	query := `
+		SELECT message_id, label_id
+		FROM label_associations
+		WHERE our_jid = $1 AND message_id = ANY($2)
+		ORDER BY label_id
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to query message labels: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID, labelID string
+		if err := rows.Scan(&messageID, &labelID); err != nil {
+			return fmt.Errorf("failed to scan message label: %w", err)
+		}
+
+		msg := &messages[index[messageID]]
+		msg.LabelIDs = append(msg.LabelIDs, labelID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating message labels: %w", err)
+	}
+
+	return nil
+}
+
+// scanLabel scans a row selected with labelColumns into a label
+func scanLabel(row interface{ Scan(dest ...any) error }) (*types.Label, error) {
+	var label types.Label
+	if err := row.Scan(
+		&label.ID,
+		&label.Name,
+		&label.Color,
+		&label.PredefinedID,
+		&label.ChatCount,
+		&label.MessageCount,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan label: %w", err)
+	}
+	return &label, nil
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GetCachedLookup loads a cached lookup result into dest if it is younger than maxAge
+// Returns the time the result was fetched, or a zero time if there is no fresh result
+func (ms *MessageStore) GetCachedLookup(ctx context.Context, ourJID, kind, jid string, maxAge time.Duration, dest any) (time.Time, error) {
+	query := `
+		SELECT data, fetched_at
+		FROM lookup_cache
+		WHERE our_jid = $1 AND kind = $2 AND jid = $3 AND fetched_at > $4
+	`
+
+	var data []byte
+	var fetchedAt time.Time
+	err := ms.db.QueryRowContext(ctx, query, ourJID, kind, jid, time.Now().Add(-maxAge)).Scan(&data, &fetchedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to query lookup cache: %w", err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode cached lookup: %w", err)
+	}
+
+	return fetchedAt, nil
+}
+
+// SaveCachedLookup stores the result of a lookup, replacing the previous result
+func (ms *MessageStore) SaveCachedLookup(ctx context.Context, ourJID, kind, jid string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode lookup: %w", err)
+	}
+
+	query := `
+		INSERT INTO lookup_cache (our_jid, kind, jid, data, fetched_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (our_jid, kind, jid) DO UPDATE SET
+			data = EXCLUDED.data,
+			fetched_at = EXCLUDED.fetched_at
+	`
+
+	if _, err := ms.db.ExecContext(ctx, query, ourJID, kind, jid, data); err != nil {
+		return fmt.Errorf("failed to save lookup: %w", err)
+	}
+
+	return nil
+}
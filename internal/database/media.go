@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrMediaNotFound is returned when no media is stored for a message
+var ErrMediaNotFound = errors.New("no media found for message")
+
+// MediaRecord holds the information needed to download and decrypt a media message
+type MediaRecord struct {
+	MessageID     string
+	MediaType     string
+	DirectPath    string
+	MediaKey      []byte
+	FileSHA256    []byte
+	FileEncSHA256 []byte
+	MimeType      string
+	FileLength    uint64
+	FileName      string // Original filename, read from the messages table
+	LocalPath     string // Cached decrypted file, empty if not downloaded yet
+}
+
+// SaveMedia saves download metadata for a media message
+func (ms *MessageStore) SaveMedia(ctx context.Context, media MediaRecord, ourJID string) error {
+	query := `
+		INSERT INTO message_media (
+			message_id, our_jid, media_type, direct_path, media_key,
+			file_sha256, file_enc_sha256, mimetype, file_length
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (message_id) DO UPDATE SET
+			media_type = EXCLUDED.media_type,
+			direct_path = EXCLUDED.direct_path,
+			media_key = EXCLUDED.media_key,
+			file_sha256 = EXCLUDED.file_sha256,
+			file_enc_sha256 = EXCLUDED.file_enc_sha256,
+			mimetype = EXCLUDED.mimetype,
+			file_length = EXCLUDED.file_length,
+			updated_at = NOW()
+	`
+
+	_, err := ms.db.ExecContext(ctx, query,
+		media.MessageID,
+		ourJID,
+		media.MediaType,
+		media.DirectPath,
+		media.MediaKey,
+		media.FileSHA256,
+		media.FileEncSHA256,
+		media.MimeType,
+		int64(media.FileLength),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save media: %w", err)
+	}
+
+	return nil
+}
+
+// GetMedia retrieves download metadata for a media message
+// Returns ErrMessageDeleted for messages deleted for everyone unless includeDeleted is set,
+// like their content is hidden in message lists
+func (ms *MessageStore) GetMedia(ctx context.Context, ourJID, messageID string, includeDeleted bool) (*MediaRecord, error) {
+	query := `
+		SELECT m.message_id, m.media_type, m.direct_path, m.media_key, m.file_sha256, m.file_enc_sha256,
+			m.mimetype, m.file_length, m.local_path, msg.media_filename, msg.deleted_at
+		FROM message_media m
+		JOIN messages msg ON msg.id = m.message_id
+		WHERE m.our_jid = $1 AND m.message_id = $2
+	`
+
+	var media MediaRecord
+	var mimeType, localPath, filename sql.NullString
+	var fileLength, deletedAt sql.NullInt64
+
+	err := ms.db.QueryRowContext(ctx, query, ourJID, messageID).Scan(
+		&media.MessageID,
+		&media.MediaType,
+		&media.DirectPath,
+		&media.MediaKey,
+		&media.FileSHA256,
+		&media.FileEncSHA256,
+		&mimeType,
+		&fileLength,
+		&localPath,
+		&filename,
+		&deletedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrMediaNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query media: %w", err)
+	}
+	if deletedAt.Valid && !includeDeleted {
+		return nil, ErrMessageDeleted
+	}
+
+	media.MimeType = mimeType.String
+	media.FileLength = uint64(fileLength.Int64)
+	media.LocalPath = localPath.String
+	media.FileName = filename.String
+
+	return &media, nil
+}
+
+// SetMediaLocalPath records where the decrypted media file is cached
+func (ms *MessageStore) SetMediaLocalPath(ctx context.Context, ourJID, messageID, localPath string) error {
+	query := `UPDATE message_media SET local_path = $1, updated_at = NOW() WHERE our_jid = $2 AND message_id = $3`
+
+	_, err := ms.db.ExecContext(ctx, query, localPath, ourJID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to update media local path: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/lib/pq"
+)
+
+// ErrPollNotFound is returned when no poll is stored for a message
+var ErrPollNotFound = errors.New("poll not found")
+
+// Poll represents the definition of a poll message
+type Poll struct {
+	MessageID       string
+	ChatJID         string
+	Question        string
+	Options         []string
+	SelectableCount int
+}
+
+// PollVote represents the current selection of a voter in a poll
+type PollVote struct {
+	PollMessageID   string
+	VoterJID        string
+	SelectedOptions []string // Empty if the vote was retracted
+	Timestamp       int64
+}
+
+// SavePoll saves the definition of a poll message
+func (ms *MessageStore) SavePoll(ctx context.Context, poll Poll, ourJID string) error {
+	query := `
+		INSERT INTO polls (message_id, our_jid, chat_jid, question, options, selectable_count)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (message_id) DO NOTHING
+	`
+
+	_, err := ms.db.ExecContext(ctx, query,
+		poll.MessageID,
+		ourJID,
+		poll.ChatJID,
+		poll.Question,
+		pq.Array(poll.Options),
+		poll.SelectableCount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save poll: %w", err)
+	}
+
+	return nil
+}
+
+// GetPoll retrieves the definition of a poll message
+func (ms *MessageStore) GetPoll(ctx context.Context, ourJID, messageID string) (*Poll, error) {
+	query := `
+		SELECT message_id, chat_jid, question, options, selectable_count
+		FROM polls
+		WHERE our_jid = $1 AND message_id = $2
+	`
+
+	var poll Poll
+	err := ms.db.QueryRowContext(ctx, query, ourJID, messageID).Scan(
+		&poll.MessageID,
+		&poll.ChatJID,
+		&poll.Question,
+		pq.Array(&poll.Options),
+		&poll.SelectableCount,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrPollNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query poll: %w", err)
+	}
+
+	return &poll, nil
+}
+
+// SavePollVote stores the latest vote of a voter, ignoring votes older than the stored one
+// Newer votes always replace the stored one, also with the same options, so an older vote replayed
+// later cannot overwrite them. Returns whether the selected options changed.
+func (ms *MessageStore) SavePollVote(ctx context.Context, vote PollVote, ourJID string) (bool, error) {
+	query := `
+		WITH previous AS (
+			SELECT selected_options FROM poll_votes
+			WHERE our_jid = $1 AND poll_message_id = $2 AND voter_jid = $3
+		)
+		INSERT INTO poll_votes (our_jid, poll_message_id, voter_jid, selected_options, timestamp)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, poll_message_id, voter_jid) DO UPDATE SET
+			selected_options = EXCLUDED.selected_options,
+			timestamp = EXCLUDED.timestamp,
+			updated_at = NOW()
+		WHERE poll_votes.timestamp < EXCLUDED.timestamp
+			OR (poll_votes.timestamp = EXCLUDED.timestamp AND poll_votes.selected_options IS DISTINCT FROM EXCLUDED.selected_options)
+		RETURNING poll_votes.selected_options IS DISTINCT FROM (SELECT selected_options FROM previous)
+	`
+
+	selected := vote.SelectedOptions
+	if selected == nil {
+		selected = []string{}
+	}
+
+	var changed bool
+	err := ms.db.QueryRowContext(ctx, query,
+		ourJID,
+		vote.PollMessageID,
+		vote.VoterJID,
+		pq.Array(selected),
+		vote.Timestamp,
+	).Scan(&changed)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The stored vote is newer or the same
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to save poll vote: %w", err)
+	}
+
+	return changed, nil
+}
+
+// GetPollResults tallies the current votes of a poll
+func (ms *MessageStore) GetPollResults(ctx context.Context, ourJID, messageID string) (*types.PollResultsResponse, error) {
+	poll, err := ms.GetPoll(ctx, ourJID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &types.PollResultsResponse{
+		Success:         true,
+		MessageID:       poll.MessageID,
+		Chat:            poll.ChatJID,
+		Question:        poll.Question,
+		SelectableCount: poll.SelectableCount,
+		Options:         make([]types.PollOptionResult, len(poll.Options)),
+	}
+	index := make(map[string]int, len(poll.Options))
+	for i, option := range poll.Options {
+		results.Options[i] = types.PollOptionResult{Name: option, Voters: []string{}}
+		index[option] = i
+	}
+
+	query := `
+		SELECT voter_jid, selected_options
+		FROM poll_votes
+		WHERE our_jid = $1 AND poll_message_id = $2 AND cardinality(selected_options) > 0
+		ORDER BY timestamp
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query poll votes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var voterJID string
+		var selected []string
+		if err := rows.Scan(&voterJID, pq.Array(&selected)); err != nil {
+			return nil, fmt.Errorf("failed to scan poll vote: %w", err)
+		}
+
+		results.TotalVoters++
+		for _, option := range selected {
+			if i, ok := index[option]; ok {
+				results.Options[i].Votes++
+				results.Options[i].Voters = append(results.Options[i].Voters, voterJID)
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating poll votes: %w", err)
+	}
+
+	return results, nil
+}
@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -114,6 +116,12 @@ func HealthCheck(db *sql.DB) error {
 	return nil
 }
 
+// ErrMessageNotFound is returned when a message does not exist in the database
+var ErrMessageNotFound = errors.New("message not found")
+
+// ErrMessageDeleted is returned when the content of a message deleted for everyone is requested
+var ErrMessageDeleted = errors.New("message was deleted for everyone")
+
 // MessageStore handles database operations for messages
 type MessageStore struct {
 	db *sql.DB
@@ -129,16 +137,40 @@ func (ms *MessageStore) GetDB() *sql.DB {
 	return ms.db
 }
 
+// messageColumns lists the columns selected for a message row, in scanMessageRows order
+const messageColumns = `id, chat_jid, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id, message_type,
+	media_mimetype, media_filename, media_file_length, media_page_count, media_duration_seconds,
+	location_latitude, location_longitude, location_name, location_address, location_accuracy_meters, contacts,
+	group_invite, group_event, edited_at, deleted_at, deleted_by`
+
 // SaveMessage saves a message to the database
+// The chat list is not updated, callers refresh the chat with RefreshChat once they stored its messages
 func (ms *MessageStore) SaveMessage(ctx context.Context, msg types.Message, ourJID string) error {
 	query := `
 		INSERT INTO messages (
 			id, our_jid, chat_jid, sender_jid, recipient_jid, 
 			message_text, timestamp, message_type, quoted_message_id, 
-			is_from_me, is_read
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			is_from_me, is_read,
+			media_mimetype, media_filename, media_file_length, media_page_count, media_duration_seconds,
+			location_latitude, location_longitude, location_name, location_address, location_accuracy_meters,
+			contacts, group_invite, group_event
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 		ON CONFLICT (id) DO UPDATE SET
-			message_text = EXCLUDED.message_text,
+			message_text = CASE WHEN messages.edited_at IS NULL THEN EXCLUDED.message_text ELSE messages.message_text END,
+			message_type = EXCLUDED.message_type,
+			media_mimetype = COALESCE(EXCLUDED.media_mimetype, messages.media_mimetype),
+			media_filename = COALESCE(EXCLUDED.media_filename, messages.media_filename),
+			media_file_length = COALESCE(EXCLUDED.media_file_length, messages.media_file_length),
+			media_page_count = COALESCE(EXCLUDED.media_page_count, messages.media_page_count),
+			media_duration_seconds = COALESCE(EXCLUDED.media_duration_seconds, messages.media_duration_seconds),
+			location_latitude = COALESCE(EXCLUDED.location_latitude, messages.location_latitude),
+			location_longitude = COALESCE(EXCLUDED.location_longitude, messages.location_longitude),
+			location_name = COALESCE(EXCLUDED.location_name, messages.location_name),
+			location_address = COALESCE(EXCLUDED.location_address, messages.location_address),
+			location_accuracy_meters = COALESCE(EXCLUDED.location_accuracy_meters, messages.location_accuracy_meters),
+			contacts = COALESCE(EXCLUDED.contacts, messages.contacts),
+			group_invite = COALESCE(EXCLUDED.group_invite, messages.group_invite),
+			group_event = COALESCE(EXCLUDED.group_event, messages.group_event),
 			is_read = EXCLUDED.is_read,
 			updated_at = NOW()
 	`
@@ -149,7 +181,91 @@ func (ms *MessageStore) SaveMessage(ctx context.Context, msg types.Message, ourJ
 	isFromMe := msg.From == "self"
 	isRead := isFromMe // Только наши сообщения считаются прочитанными
 
-	_, err := ms.db.ExecContext(ctx, query,
+	messageType := msg.MessageType
+	if messageType == "" {
+		messageType = types.MessageTypeText
+	}
+
+	// Group events are system messages and never count as unread
+	if messageType == types.MessageTypeGroupEvent {
+		isRead = true
+	}
+
+	// Media metadata is only stored for media messages
+	var mediaMimeType, mediaFilename sql.NullString
+	var mediaFileLength sql.NullInt64
+	var mediaPageCount, mediaDuration sql.NullInt32
+	if msg.Media != nil {
+		mediaMimeType = sql.NullString{String: msg.Media.MimeType, Valid: msg.Media.MimeType != ""}
+		mediaFilename = sql.NullString{String: msg.Media.FileName, Valid: msg.Media.FileName != ""}
+		mediaFileLength = sql.NullInt64{Int64: int64(msg.Media.FileLength), Valid: msg.Media.FileLength > 0}
+		mediaPageCount = sql.NullInt32{Int32: int32(msg.Media.PageCount), Valid: msg.Media.PageCount > 0}
+		mediaDuration = sql.NullInt32{Int32: int32(msg.Media.DurationSeconds), Valid: msg.Media.DurationSeconds > 0}
+	}
+
+	// Coordinates are only stored for location messages
+	var latitude, longitude sql.NullFloat64
+	var locationName, locationAddress sql.NullString
+	var locationAccuracy sql.NullInt32
+	if msg.Location != nil {
+		latitude = sql.NullFloat64{Float64: msg.Location.Latitude, Valid: true}
+		longitude = sql.NullFloat64{Float64: msg.Location.Longitude, Valid: true}
+		locationName = sql.NullString{String: msg.Location.Name, Valid: msg.Location.Name != ""}
+		locationAddress = sql.NullString{String: msg.Location.Address, Valid: msg.Location.Address != ""}
+		locationAccuracy = sql.NullInt32{Int32: int32(msg.Location.AccuracyMeters), Valid: msg.Location.AccuracyMeters > 0}
+	}
+
+	// Contact cards are stored as a JSON array
+	var contacts sql.NullString
+	if len(msg.Contacts) > 0 {
+		encoded, err := json.Marshal(msg.Contacts)
+		if err != nil {
+			return fmt.Errorf("failed to encode contacts: %w", err)
+		}
+		contacts = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	// Group invites are stored as a JSON object
+	var groupInvite sql.NullString
+	if msg.GroupInvite != nil {
+		encoded, err := json.Marshal(msg.GroupInvite)
+		if err != nil {
+			return fmt.Errorf("failed to encode group invite: %w", err)
+		}
+		groupInvite = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	// Group events are stored as a JSON object
+	var groupEvent sql.NullString
+	if msg.GroupEvent != nil {
+		encoded, err := json.Marshal(msg.GroupEvent)
+		if err != nil {
+			return fmt.Errorf("failed to encode group event: %w", err)
+		}
+		groupEvent = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	tx, err := ms.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// A redelivered message with different text must not silently overwrite it, keep the previous version.
+	// Edited messages keep their edited text, which is only changed through EditMessage.
+	if msg.Text != "" {
+		revisionQuery := `
+			INSERT INTO message_revisions (our_jid, message_id, message_text, replaced_at)
+			SELECT our_jid, id, message_text, EXTRACT(EPOCH FROM NOW())::BIGINT
+			FROM messages
+			WHERE id = $1 AND our_jid = $2 AND edited_at IS NULL AND message_text != '' AND message_text != $3
+		`
+		if _, err := tx.ExecContext(ctx, revisionQuery, msg.ID, ourJID, msg.Text); err != nil {
+			return fmt.Errorf("failed to save message revision: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, query,
 		msg.ID,
 		ourJID,
 		msg.Chat,
@@ -157,28 +273,179 @@ func (ms *MessageStore) SaveMessage(ctx context.Context, msg types.Message, ourJ
 		msg.To,
 		msg.Text,
 		msg.Timestamp,
-		"text", // message_type - можем расширить позже для других типов
+		messageType,
 		msg.QuotedMessageID,
 		isFromMe, // is_from_me
 		isRead,   // is_read - входящие сообщения непрочитанные, исходящие прочитанные
+		mediaMimeType,
+		mediaFilename,
+		mediaFileLength,
+		mediaPageCount,
+		mediaDuration,
+		latitude,
+		longitude,
+		locationName,
+		locationAddress,
+		locationAccuracy,
+		contacts,
+		groupInvite,
+		groupEvent,
 	)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit message: %w", err)
+	}
+
+	return nil
+}
+
+// scanMessageRows scans rows selected with messageColumns into messages
+func scanMessageRows(rows *sql.Rows) ([]types.Message, error) {
+	var messages []types.Message
+	for rows.Next() {
+		var msg types.Message
+		var recipientJID sql.NullString
+		var quotedMessageID sql.NullString
+		var mediaMimeType, mediaFilename sql.NullString
+		var mediaFileLength sql.NullInt64
+		var mediaPageCount, mediaDuration sql.NullInt32
+		var latitude, longitude sql.NullFloat64
+		var locationName, locationAddress sql.NullString
+		var locationAccuracy sql.NullInt32
+		var contacts, groupInvite, groupEvent []byte
+		var editedAt, deletedAt sql.NullInt64
+		var deletedBy sql.NullString
+
+		err := rows.Scan(
+			&msg.ID,
+			&msg.Chat,
+			&msg.From,
+			&recipientJID,
+			&msg.Text,
+			&msg.Timestamp,
+			&quotedMessageID,
+			&msg.MessageType,
+			&mediaMimeType,
+			&mediaFilename,
+			&mediaFileLength,
+			&mediaPageCount,
+			&mediaDuration,
+			&latitude,
+			&longitude,
+			&locationName,
+			&locationAddress,
+			&locationAccuracy,
+			&contacts,
+			&groupInvite,
+			&groupEvent,
+			&editedAt,
+			&deletedAt,
+			&deletedBy,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+
+		if recipientJID.Valid {
+			msg.To = recipientJID.String
+		}
+		if quotedMessageID.Valid {
+			msg.QuotedMessageID = quotedMessageID.String
+		}
+		if mediaMimeType.Valid || mediaFilename.Valid || mediaFileLength.Valid || mediaPageCount.Valid || mediaDuration.Valid {
+			msg.Media = &types.MediaInfo{
+				MimeType:        mediaMimeType.String,
+				FileName:        mediaFilename.String,
+				FileLength:      uint64(mediaFileLength.Int64),
+				PageCount:       int(mediaPageCount.Int32),
+				DurationSeconds: uint32(mediaDuration.Int32),
+			}
+		}
+
+		if latitude.Valid && longitude.Valid {
+			msg.Location = &types.LocationInfo{
+				Latitude:       latitude.Float64,
+				Longitude:      longitude.Float64,
+				Name:           locationName.String,
+				Address:        locationAddress.String,
+				AccuracyMeters: uint32(locationAccuracy.Int32),
+			}
+		}
+
+		if editedAt.Valid {
+			msg.EditedAt = editedAt.Int64
+		}
+		if deletedAt.Valid {
+			msg.DeletedAt = deletedAt.Int64
+			msg.DeletedBy = deletedBy.String
+		}
+		if len(contacts) > 0 {
+			if err := json.Unmarshal(contacts, &msg.Contacts); err != nil {
+				return nil, fmt.Errorf("failed to decode contacts: %w", err)
+			}
+		}
+		if len(groupInvite) > 0 {
+			if err := json.Unmarshal(groupInvite, &msg.GroupInvite); err != nil {
+				return nil, fmt.Errorf("failed to decode group invite: %w", err)
+			}
+		}
+		if len(groupEvent) > 0 {
+			if err := json.Unmarshal(groupEvent, &msg.GroupEvent); err != nil {
+				return nil, fmt.Errorf("failed to decode group event: %w", err)
+			}
+		}
+
+		messages = append(messages, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating messages: %w", err)
+	}
 
-	return err
+	return messages, nil
+}
+
+// redactDeleted removes the content of messages deleted for everyone, keeping who deleted them and when
+func redactDeleted(messages []types.Message) {
+	for i := range messages {
+		if messages[i].DeletedAt == 0 {
+			continue
+		}
+		messages[i].Text = ""
+		messages[i].QuotedMessageID = ""
+		messages[i].Media = nil
+		messages[i].Location = nil
+		messages[i].Contacts = nil
+		messages[i].GroupInvite = nil
+		messages[i].EditedAt = 0
+	}
+}
+
+// reverseMessages reverses the slice in place to get chronological order (oldest first)
+func reverseMessages(messages []types.Message) {
+	for i := len(messages)/2 - 1; i >= 0; i-- {
+		opp := len(messages) - 1 - i
+		messages[i], messages[opp] = messages[opp], messages[i]
+	}
 }
 
 // GetChatMessages retrieves messages for a specific chat with pagination
-func (ms *MessageStore) GetChatMessages(ctx context.Context, ourJID, chatJID string, count int, beforeMessageID string) ([]types.Message, error) {
+// The content of deleted messages is redacted unless includeDeleted is set
+func (ms *MessageStore) GetChatMessages(ctx context.Context, ourJID, chatJID string, count int, beforeMessageID string, includeDeleted bool) ([]types.Message, error) {
 	var query string
 	var args []interface{}
 
 	if beforeMessageID != "" {
 		// Get messages before a specific message (for pagination)
 		query = `
-			SELECT id, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id
+			SELECT ` + messageColumns + `
 			FROM messages 
 			WHERE our_jid = $1 AND chat_jid = $2 AND timestamp < (
 				SELECT timestamp FROM messages WHERE id = $3 AND our_jid = $1
-			) AND message_text IS NOT NULL AND TRIM(message_text) != ''
+			) AND (message_type != 'text' OR TRIM(message_text) != '')
 			ORDER BY timestamp DESC 
 			LIMIT $4
 		`
@@ -186,9 +453,9 @@ func (ms *MessageStore) GetChatMessages(ctx context.Context, ourJID, chatJID str
 	} else {
 		// Get latest messages
 		query = `
-			SELECT id, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id
+			SELECT ` + messageColumns + `
 			FROM messages 
-			WHERE our_jid = $1 AND chat_jid = $2 AND message_text IS NOT NULL AND TRIM(message_text) != ''
+			WHERE our_jid = $1 AND chat_jid = $2 AND (message_type != 'text' OR TRIM(message_text) != '')
 			ORDER BY timestamp DESC 
 			LIMIT $3
 		`
@@ -201,52 +468,61 @@ func (ms *MessageStore) GetChatMessages(ctx context.Context, ourJID, chatJID str
 	}
 	defer rows.Close()
 
-	var messages []types.Message
-	for rows.Next() {
-		var msg types.Message
-		var recipientJID sql.NullString
-		var quotedMessageID sql.NullString
+	messages, err := scanMessageRows(rows)
+	if err != nil {
+		return nil, err
+	}
 
-		err := rows.Scan(
-			&msg.ID,
-			&msg.From,
-			&recipientJID,
-			&msg.Text,
-			&msg.Timestamp,
-			&quotedMessageID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan message: %w", err)
-		}
+	reverseMessages(messages)
 
-		msg.Chat = chatJID
-		if recipientJID.Valid {
-			msg.To = recipientJID.String
-		}
-		if quotedMessageID.Valid {
-			msg.QuotedMessageID = quotedMessageID.String
-		}
+	if !includeDeleted {
+		redactDeleted(messages)
+	}
 
-		messages = append(messages, msg)
+	if err := ms.attachReactions(ctx, ourJID, messages); err != nil {
+		return nil, err
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating messages: %w", err)
+	if err := ms.attachLabels(ctx, ourJID, messages); err != nil {
+		return nil, err
 	}
 
-	// Reverse the slice to get chronological order (oldest first)
-	for i := len(messages)/2 - 1; i >= 0; i-- {
-		opp := len(messages) - 1 - i
-		messages[i], messages[opp] = messages[opp], messages[i]
+	if err := ms.attachContactNames(ctx, ourJID, messages); err != nil {
+		return nil, err
 	}
 
 	return messages, nil
 }
 
+// GetMessage retrieves a single message by ID
+func (ms *MessageStore) GetMessage(ctx context.Context, ourJID, messageID string) (*types.Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages 
+		WHERE our_jid = $1 AND id = $2
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+	defer rows.Close()
+
+	messages, err := scanMessageRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, ErrMessageNotFound
+	}
+
+	return &messages[0], nil
+}
+
 // GetAllMessages retrieves all messages for a user (used for counting)
 func (ms *MessageStore) GetAllMessages(ctx context.Context, ourJID string) ([]types.Message, error) {
 	query := `
-		SELECT id, chat_jid, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id
+		SELECT ` + messageColumns + `
 		FROM messages 
 		WHERE our_jid = $1
 		ORDER BY timestamp DESC
@@ -258,40 +534,7 @@ func (ms *MessageStore) GetAllMessages(ctx context.Context, ourJID string) ([]ty
 	}
 	defer rows.Close()
 
-	var messages []types.Message
-	for rows.Next() {
-		var msg types.Message
-		var recipientJID sql.NullString
-		var quotedMessageID sql.NullString
-
-		err := rows.Scan(
-			&msg.ID,
-			&msg.Chat,
-			&msg.From,
-			&recipientJID,
-			&msg.Text,
-			&msg.Timestamp,
-			&quotedMessageID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan message: %w", err)
-		}
-
-		if recipientJID.Valid {
-			msg.To = recipientJID.String
-		}
-		if quotedMessageID.Valid {
-			msg.QuotedMessageID = quotedMessageID.String
-		}
-
-		messages = append(messages, msg)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating all messages: %w", err)
-	}
-
-	return messages, nil
+	return scanMessageRows(rows)
 }
 
 // GetChatMessageCount returns the total count of messages in a chat
@@ -335,6 +578,26 @@ func (ms *MessageStore) MarkMessagesAsRead(ctx context.Context, ourJID, chatJID
 	if err != nil {
 		return fmt.Errorf("failed to mark messages as read: %w", err)
 	}
+	if err := ms.ClearHistoryUnreadCount(ctx, ourJID, chatJID); err != nil {
+		return err
+	}
+	if err := ms.RefreshChat(ctx, ourJID, chatJID); err != nil {
+		return err
+	}
+
+	// Reading a chat also clears its marked unread flag
+	markedUnread := false
+	return ms.SaveChatInfo(ctx, ChatInfo{JID: chatJID, MarkedUnread: &markedUnread}, ourJID)
+}
+
+// MarkMessageDeleted marks a message as deleted for everyone, keeping its content for compliance
+func (ms *MessageStore) MarkMessageDeleted(ctx context.Context, ourJID, messageID, deletedBy string, deletedAt int64) error {
+	query := `UPDATE messages SET deleted_at = $1, deleted_by = $2 WHERE our_jid = $3 AND id = $4 AND deleted_at IS NULL`
+
+	_, err := ms.db.ExecContext(ctx, query, deletedAt, deletedBy, ourJID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to mark message as deleted: %w", err)
+	}
 
 	return nil
 }
@@ -347,9 +610,9 @@ func (ms *MessageStore) GetUnreadMessages(ctx context.Context, ourJID string, ch
 	if chatJID != "" {
 		// Get unread messages from a specific chat
 		query = `
-			SELECT id, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id, chat_jid
+			SELECT ` + messageColumns + `
 			FROM messages 
-			WHERE our_jid = $1 AND chat_jid = $2 AND is_read = false AND message_text IS NOT NULL AND TRIM(message_text) != ''
+			WHERE our_jid = $1 AND chat_jid = $2 AND is_read = false AND (message_type != 'text' OR TRIM(message_text) != '')
 			ORDER BY timestamp DESC 
 			LIMIT $3
 		`
@@ -357,9 +620,9 @@ func (ms *MessageStore) GetUnreadMessages(ctx context.Context, ourJID string, ch
 	} else {
 		// Get unread messages from all chats
 		query = `
-			SELECT id, sender_jid, recipient_jid, message_text, timestamp, quoted_message_id, chat_jid
+			SELECT ` + messageColumns + `
 			FROM messages 
-			WHERE our_jid = $1 AND is_read = false AND message_text IS NOT NULL AND TRIM(message_text) != ''
+			WHERE our_jid = $1 AND is_read = false AND (message_type != 'text' OR TRIM(message_text) != '')
 			ORDER BY timestamp DESC 
 			LIMIT $2
 		`
@@ -372,43 +635,16 @@ func (ms *MessageStore) GetUnreadMessages(ctx context.Context, ourJID string, ch
 	}
 	defer rows.Close()
 
-	var messages []types.Message
-	for rows.Next() {
-		var msg types.Message
-		var recipientJID sql.NullString
-		var quotedMessageID sql.NullString
-
-		err := rows.Scan(
-			&msg.ID,
-			&msg.From,
-			&recipientJID,
-			&msg.Text,
-			&msg.Timestamp,
-			&quotedMessageID,
-			&msg.Chat,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan unread message: %w", err)
-		}
-
-		if recipientJID.Valid {
-			msg.To = recipientJID.String
-		}
-		if quotedMessageID.Valid {
-			msg.QuotedMessageID = quotedMessageID.String
-		}
-
-		messages = append(messages, msg)
+	messages, err := scanMessageRows(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating unread messages: %w", err)
-	}
+	reverseMessages(messages)
+	redactDeleted(messages)
 
-	// Reverse the slice to get chronological order (oldest first)
-	for i := len(messages)/2 - 1; i >= 0; i-- {
-		opp := len(messages) - 1 - i
-		messages[i], messages[opp] = messages[opp], messages[i]
+	if err := ms.attachContactNames(ctx, ourJID, messages); err != nil {
+		return nil, err
 	}
 
 	return messages, nil
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/lib/pq"
+)
+
+// Reaction represents an emoji reaction of a sender to a message
+type Reaction struct {
+	MessageID string
+	ChatJID   string
+	SenderJID string
+	Emoji     string // Empty when the reaction was removed
+	Timestamp int64
+}
+
+// SaveReaction stores the latest reaction of a sender to a message
+// Reactions older than the stored one are ignored, as history sync may deliver them out of order.
+// Removed reactions are kept with an empty emoji, so an older reaction delivered late cannot bring them back
+func (ms *MessageStore) SaveReaction(ctx context.Context, reaction Reaction, ourJID string) error {
+	query := `
+		INSERT INTO message_reactions (our_jid, message_id, sender_jid, chat_jid, emoji, timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, message_id, sender_jid) DO UPDATE SET
+			emoji = EXCLUDED.emoji,
+			timestamp = EXCLUDED.timestamp,
+			updated_at = NOW()
+		WHERE message_reactions.timestamp <= EXCLUDED.timestamp
+	`
+
+	_, err := ms.db.ExecContext(ctx, query,
+		ourJID,
+		reaction.MessageID,
+		reaction.SenderJID,
+		reaction.ChatJID,
+		reaction.Emoji,
+		reaction.Timestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save reaction: %w", err)
+	}
+
+	return nil
+}
+
+// attachReactions loads the reactions to the given messages and aggregates them by emoji
+func (ms *MessageStore) attachReactions(ctx context.Context, ourJID string, messages []types.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(messages))
+	index := make(map[string]int, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+		index[msg.ID] = i
+	}
+
+	query := `
+		SELECT message_id, emoji, sender_jid
+		FROM message_reactions
+		WHERE our_jid = $1 AND message_id = ANY($2) AND emoji != ''
+		ORDER BY timestamp
+	`
+
+	rows, err := ms.db.QueryContext(ctx, query, ourJID, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to query reactions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID, emoji, senderJID string
+		if err := rows.Scan(&messageID, &emoji, &senderJID); err != nil {
+			return fmt.Errorf("failed to scan reaction: %w", err)
+		}
+
+		msg := &messages[index[messageID]]
+		found := false
+		for i := range msg.Reactions {
+			if msg.Reactions[i].Emoji == emoji {
+				msg.Reactions[i].Count++
+				msg.Reactions[i].Senders = append(msg.Reactions[i].Senders, senderJID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg.Reactions = append(msg.Reactions, types.ReactionSummary{
+				Emoji:   emoji,
+				Count:   1,
+				Senders: []string{senderJID},
+			})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating reactions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNotAuthor is returned when a message is edited by someone else than its author
+var ErrNotAuthor = errors.New("edit was not sent by the author of the message")
+
+// ErrEditWindowExpired is returned when an edit is made too long after the message was sent
+var ErrEditWindowExpired = errors.New("edit was made after the edit window of the message")
+
+// EditMessage replaces the text of a message, keeping the previous text as a revision
+// Only edits from the author of the message within window after it was sent are applied,
+// edits older than the last applied edit are ignored. Returns the text before the edit.
+func (ms *MessageStore) EditMessage(ctx context.Context, ourJID, messageID, senderJID, newText string, editedAt int64, window time.Duration) (string, error) {
+	tx, err := ms.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var previousText, author string
+	var sentAt int64
+	var lastEditedAt sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		`SELECT message_text, sender_jid, timestamp, edited_at FROM messages WHERE our_jid = $1 AND id = $2 FOR UPDATE`,
+		ourJID, messageID,
+	).Scan(&previousText, &author, &sentAt, &lastEditedAt)
+	if err == sql.ErrNoRows {
+		return "", ErrMessageNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to query message: %w", err)
+	}
+
+	if !sameSender(ourJID, author, senderJID) {
+		return "", ErrNotAuthor
+	}
+	if editedAt-sentAt > int64(window/time.Second) {
+		return "", ErrEditWindowExpired
+	}
+
+	// Edits may arrive out of order from history sync
+	if lastEditedAt.Valid && lastEditedAt.Int64 > editedAt {
+		return previousText, nil
+	}
+
+	if previousText != newText {
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO message_revisions (our_jid, message_id, message_text, replaced_at) VALUES ($1, $2, $3, $4)`,
+			ourJID, messageID, previousText, editedAt,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to save message revision: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE messages SET message_text = $1, edited_at = $2 WHERE our_jid = $3 AND id = $4`,
+		newText, editedAt, ourJID, messageID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to update message text: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit message edit: %w", err)
+	}
+
+	return previousText, nil
+}
+
+// sameSender reports whether two stored senders are the same user, ignoring their devices
+// Our own messages are stored with the sender "self"
+func sameSender(ourJID, a, b string) bool {
+	return senderUser(ourJID, a) == senderUser(ourJID, b)
+}
+
+// senderUser returns the user part of a stored sender JID, without agent and device
+func senderUser(ourJID, senderJID string) string {
+	if senderJID == "self" {
+		senderJID = ourJID
+	}
+	user, server, _ := strings.Cut(senderJID, "@")
+	user, _, _ = strings.Cut(user, ":")
+	user, _, _ = strings.Cut(user, ".")
+	return user + "@" + server
+}
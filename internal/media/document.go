@@ -0,0 +1,120 @@
+package media
+
+import (
+	"archive/zip"
+	"bytes"
+	"mime"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// knownMimeTypes maps common file extensions to MIME types not reliably known by the mime package
+var knownMimeTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".doc":  "application/msword",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xls":  "application/vnd.ms-excel",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".ppt":  "application/vnd.ms-powerpoint",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".csv":  "text/csv",
+	".txt":  "text/plain",
+	".json": "application/json",
+	".zip":  "application/zip",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".webp": "image/webp",
+	".mp4":  "video/mp4",
+	".ogg":  "audio/ogg",
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+}
+
+// pdfPagePattern matches page objects in a PDF, excluding the /Pages tree nodes
+var pdfPagePattern = regexp.MustCompile(`/Type\s*/Page[^s]`)
+
+// DetectDocumentMimeType determines the MIME type of a document from its content and filename
+func DetectDocumentMimeType(data []byte, filename string) string {
+	sniffed := DetectMimeType(data)
+
+	// Office Open XML files are ZIP archives, look inside to tell them apart
+	if sniffed == "application/zip" {
+		if ooxml := detectOOXML(data); ooxml != "" {
+			return ooxml
+		}
+	}
+
+	// Sniffing only yields generic types for many document formats, prefer the extension then
+	if sniffed == "application/octet-stream" || sniffed == "application/zip" || sniffed == "text/plain" {
+		if byExt := MimeTypeByFilename(filename); byExt != "" {
+			return byExt
+		}
+	}
+
+	return sniffed
+}
+
+// MimeTypeByFilename returns the MIME type for the extension of filename, or an empty string if unknown
+func MimeTypeByFilename(filename string) string {
+	ext := strings.ToLower(filepath.Ext(filename))
+	if ext == "" {
+		return ""
+	}
+	if mimeType, ok := knownMimeTypes[ext]; ok {
+		return mimeType
+	}
+	mimeType := mime.TypeByExtension(ext)
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	return mimeType
+}
+
+// ExtensionForMimeType returns a file extension (with leading dot) for the MIME type, or an empty string if unknown
+func ExtensionForMimeType(mimeType string) string {
+	// Drop parameters such as "; codecs=opus"
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	for ext, known := range knownMimeTypes {
+		if known == mimeType {
+			return ext
+		}
+	}
+	if exts, err := mime.ExtensionsByType(mimeType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
+
+// PDFPageCount returns the number of pages in a PDF document, or 0 if it cannot be determined
+func PDFPageCount(data []byte) int {
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		return 0
+	}
+	return len(pdfPagePattern.FindAllIndex(data, -1))
+}
+
+// detectOOXML identifies Word, Excel and PowerPoint files by their archive layout
+func detectOOXML(data []byte) string {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return ""
+	}
+
+	for _, file := range reader.File {
+		switch {
+		case strings.HasPrefix(file.Name, "word/"):
+			return knownMimeTypes[".docx"]
+		case strings.HasPrefix(file.Name, "xl/"):
+			return knownMimeTypes[".xlsx"]
+		case strings.HasPrefix(file.Name, "ppt/"):
+			return knownMimeTypes[".pptx"]
+		}
+	}
+
+	return ""
+}
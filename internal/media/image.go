@@ -0,0 +1,150 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	// Register decoders for supported image formats
+	_ "image/gif"
+	_ "image/png"
+)
+
+// ThumbnailSize is the maximum width or height of generated thumbnails
+const ThumbnailSize = 100
+
+// ProfilePhotoSize is the width and height of group and profile photos
+const ProfilePhotoSize = 640
+
+// MaxImagePixels is the largest number of pixels of images that are decoded
+// Compressed images can be tiny while decoding to gigabytes, so the size is checked before decoding
+const MaxImagePixels = 50_000_000
+
+// ImageInfo contains the dimensions and thumbnail of an image
+type ImageInfo struct {
+	Width     int
+	Height    int
+	Thumbnail []byte // JPEG encoded thumbnail
+}
+
+// ProcessImage decodes an image and generates a JPEG thumbnail for it
+func ProcessImage(data []byte) (*ImageInfo, error) {
+	img, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail, err := GenerateThumbnail(img, ThumbnailSize)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	return &ImageInfo{
+		Width:     bounds.Dx(),
+		Height:    bounds.Dy(),
+		Thumbnail: thumbnail,
+	}, nil
+}
+
+// ProfilePhoto crops an image to a centered square and encodes it as a JPEG of at most ProfilePhotoSize pixels
+func ProfilePhoto(data []byte) ([]byte, error) {
+	img, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// WhatsApp only shows square photos, crop the longer side
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	x0 := bounds.Min.X + (bounds.Dx()-side)/2
+	y0 := bounds.Min.Y + (bounds.Dy()-side)/2
+	if cropper, ok := img.(interface {
+		SubImage(r image.Rectangle) image.Image
+	}); ok {
+		img = cropper.SubImage(image.Rect(x0, y0, x0+side, y0+side))
+	}
+
+	return GenerateThumbnail(img, ProfilePhotoSize)
+}
+
+// decodeImage decodes an image after checking that its dimensions stay within MaxImagePixels
+func decodeImage(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if int64(config.Width)*int64(config.Height) > MaxImagePixels {
+		return nil, fmt.Errorf("image of %dx%d pixels is too large, at most %d pixels are supported", config.Width, config.Height, MaxImagePixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
+
+// GenerateThumbnail scales an image to fit within maxSize and encodes it as JPEG
+// JPEG has no transparency, transparent areas are composited onto white like WhatsApp shows them
+func GenerateThumbnail(img image.Image, maxSize int) ([]byte, error) {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW == 0 || srcH == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	// Keep aspect ratio, never upscale
+	dstW, dstH := srcW, srcH
+	if srcW > maxSize || srcH > maxSize {
+		if srcW >= srcH {
+			dstW = maxSize
+			dstH = max(1, srcH*maxSize/srcW)
+		} else {
+			dstH = maxSize
+			dstW = max(1, srcW*maxSize/srcH)
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+
+	// Box filter: average all source pixels that map to each destination pixel
+	for y := 0; y < dstH; y++ {
+		y0 := bounds.Min.Y + y*srcH/dstH
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := bounds.Min.X + x*srcW/dstW
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/dstW)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			// Colors are premultiplied by alpha, the uncovered part shows the white background
+			background := 0xffff - a/n
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r/n + background),
+				G: uint16(g/n + background),
+				B: uint16(b/n + background),
+				A: 0xffff,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 75}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,52 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestProcessImageRejectsHugeDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+
+	// Claim 30000x30000 pixels in the header, the pixel data is never read
+	data := buf.Bytes()
+	ihdr := data[12:29]
+	binary.BigEndian.PutUint32(ihdr[4:8], 30000)
+	binary.BigEndian.PutUint32(ihdr[8:12], 30000)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(ihdr))
+
+	if _, err := ProcessImage(data); err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Fatalf("expected an error for an image above MaxImagePixels, got %v", err)
+	}
+}
+
+func TestProcessImageFlattensTransparencyOntoWhite(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := ProcessImage(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumbnail, err := jpeg.Decode(bytes.NewReader(info.Thumbnail))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, g, b, _ := thumbnail.At(4, 4).RGBA()
+	if r < 0xf000 || g < 0xf000 || b < 0xf000 {
+		t.Fatalf("expected a white thumbnail for a transparent image, got %04x %04x %04x", r, g, b)
+	}
+}
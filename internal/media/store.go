@@ -0,0 +1,371 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// publishedMediaDir is the subdirectory of the static directory holding media published with a link
+const publishedMediaDir = "media"
+
+// linkKeyFile is the file in the cache directory holding the generated key of published media names
+const linkKeyFile = "link.key"
+
+// DefaultLinkTTL is how long published media files stay available by default
+const DefaultLinkTTL = 24 * time.Hour
+
+// MaxInlineSize is the largest downloaded media file returned inline, larger files are only returned as a link
+const MaxInlineSize = 16 * 1024 * 1024
+
+// unsafeFilenameChars matches characters not allowed in cached media filenames
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// Store resolves media payloads passed to tools and manages media files in the static directory
+type Store struct {
+	staticDir string
+	mediaRoot string // Only files inside this directory can be sent by path
+	cacheDir  string // Downloaded media, not served over HTTP
+	baseURL   string
+
+	// Key deriving the unguessable names of published media files
+	linkKey []byte
+
+	// How long published media files stay available, 0 keeps them until the message is deleted
+	linkTTL time.Duration
+}
+
+// File represents a media payload loaded into memory
+type File struct {
+	Data     []byte // Raw file contents
+	Filename string // Original filename if known
+	MimeType string // Detected MIME type
+}
+
+// NewStore creates a new media store
+// File paths passed to tools are resolved inside mediaRoot; an empty mediaRoot disables sending files by path.
+// Downloaded media is cached in cacheDir, which must not be inside the static directory.
+// Published media names are derived from linkKey, or from a key generated once and kept in cacheDir if it is empty,
+// so links stay the same across restarts. Published files are removed after linkTTL, 0 disables expiry.
+func NewStore(staticDir, mediaRoot, cacheDir, baseURL, linkKey string, linkTTL time.Duration) (*Store, error) {
+	key := []byte(linkKey)
+	if linkKey == "" {
+		var err error
+		if key, err = loadLinkKey(cacheDir); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Store{
+		staticDir: staticDir,
+		mediaRoot: mediaRoot,
+		cacheDir:  cacheDir,
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		linkKey:   key,
+		linkTTL:   linkTTL,
+	}, nil
+}
+
+// loadLinkKey reads the link key kept in the cache directory, generating it on first start
+func loadLinkKey(cacheDir string) ([]byte, error) {
+	keyPath := filepath.Join(cacheDir, linkKeyFile)
+	key, err := os.ReadFile(keyPath)
+	if err == nil && len(key) > 0 {
+		return key, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read media link key: %w", err)
+	}
+
+	key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate media link key: %w", err)
+	}
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create media cache directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, key, 0600); err != nil {
+		return nil, fmt.Errorf("failed to save media link key: %w", err)
+	}
+
+	return key, nil
+}
+
+// Load reads a media payload from exactly one of base64 data, a local file path or a static URL
+func (s *Store) Load(base64Data, path, staticURL string) (*File, error) {
+	sources := 0
+	for _, source := range []string{base64Data, path, staticURL} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources == 0 {
+		return nil, fmt.Errorf("no media source provided")
+	}
+	if sources > 1 {
+		return nil, fmt.Errorf("only one media source (base64, path or url) may be provided")
+	}
+
+	switch {
+	case base64Data != "":
+		return s.loadBase64(base64Data)
+	case path != "":
+		return s.loadMediaRootFile(path)
+	default:
+		return s.loadStaticURL(staticURL)
+	}
+}
+
+// loadBase64 decodes a base64 payload, optionally wrapped in a data URI
+func (s *Store) loadBase64(base64Data string) (*File, error) {
+	// Strip data URI prefix (e.g. "data:image/png;base64,")
+	if strings.HasPrefix(base64Data, "data:") {
+		if idx := strings.Index(base64Data, ","); idx != -1 {
+			base64Data = base64Data[idx+1:]
+		}
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(base64Data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 data: %w", err)
+	}
+
+	return newFile(data, ""), nil
+}
+
+// loadMediaRootFile reads a file passed by path, which must be inside the media root directory
+// Relative paths are relative to the media root. Symlinks are resolved, so they cannot point outside of it.
+func (s *Store) loadMediaRootFile(path string) (*File, error) {
+	if s.mediaRoot == "" {
+		return nil, fmt.Errorf("sending files by path is disabled, set MEDIA_ROOT to allow it")
+	}
+
+	root, err := filepath.Abs(s.mediaRoot)
+	if err != nil {
+		return nil, fmt.Errorf("invalid media root: %w", err)
+	}
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return nil, fmt.Errorf("invalid media root: %w", err)
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file path: %w", err)
+	}
+
+	// Check the path before and after resolving symlinks, so files outside are not even looked up
+	outside := fmt.Errorf("file %s is outside of the media root directory %s", path, s.mediaRoot)
+	if !isInside(root, absPath) {
+		return nil, outside
+	}
+	if absPath, err = filepath.EvalSymlinks(absPath); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if !isInside(root, absPath) {
+		return nil, outside
+	}
+
+	return s.loadFile(absPath)
+}
+
+// isInside reports whether an absolute path is inside a directory
+func isInside(dir, path string) bool {
+	relPath, err := filepath.Rel(dir, path)
+	return err == nil && relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator))
+}
+
+// loadFile reads a file from the local filesystem
+func (s *Store) loadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return newFile(data, filepath.Base(path)), nil
+}
+
+// loadStaticURL reads a file served by the /static handler of this server
+func (s *Store) loadStaticURL(staticURL string) (*File, error) {
+	prefix := s.baseURL + "/"
+	if !strings.HasPrefix(staticURL, prefix) {
+		return nil, fmt.Errorf("URL must be served from %s", prefix)
+	}
+
+	// Resolve the relative path inside the static directory, rejecting path traversal
+	relPath := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(staticURL, prefix)))
+	if relPath == "." || strings.HasPrefix(relPath, "..") || filepath.IsAbs(relPath) {
+		return nil, fmt.Errorf("invalid static file path: %s", staticURL)
+	}
+
+	return s.loadFile(filepath.Join(s.staticDir, relPath))
+}
+
+// SaveMediaFile writes downloaded media to the private media cache directory and returns its path
+func (s *Store) SaveMediaFile(name, mimeType string, data []byte) (string, error) {
+	if err := os.MkdirAll(s.cacheDir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create media cache directory: %w", err)
+	}
+
+	filename := unsafeFilenameChars.ReplaceAllString(name, "_") + ExtensionForMimeType(mimeType)
+	filePath := filepath.Join(s.cacheDir, filename)
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write media file: %w", err)
+	}
+
+	return filePath, nil
+}
+
+// PublishMediaFile makes downloaded media available in the static directory and returns its URL
+// The file name is derived from the name with a secret key, so links cannot be guessed from message IDs.
+// Publishing a file again keeps its link and restarts its expiry.
+func (s *Store) PublishMediaFile(name, mimeType string, data []byte) (string, error) {
+	dir := filepath.Join(s.staticDir, publishedMediaDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create media directory: %w", err)
+	}
+
+	filePath := s.publishedPath(name, mimeType)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		if err := os.WriteFile(filePath, data, 0644); err != nil {
+			return "", fmt.Errorf("failed to write media file: %w", err)
+		}
+	} else {
+		now := time.Now()
+		if err := os.Chtimes(filePath, now, now); err != nil {
+			log.Printf("Failed to refresh published media file %s: %v", filePath, err)
+		}
+	}
+
+	return s.URLForPath(filePath), nil
+}
+
+// RemoveMediaFiles deletes the cached and published files of a media message, e.g. after it was deleted for everyone
+func (s *Store) RemoveMediaFiles(name, mimeType, localPath string) error {
+	paths := []string{s.publishedPath(name, mimeType)}
+	if localPath != "" {
+		paths = append(paths, localPath)
+	}
+
+	for _, filePath := range paths {
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove media file: %w", err)
+		}
+	}
+	return nil
+}
+
+// publishedPath returns the path a media file is published under
+func (s *Store) publishedPath(name, mimeType string) string {
+	mac := hmac.New(sha256.New, s.linkKey)
+	mac.Write([]byte(name))
+	return filepath.Join(s.staticDir, publishedMediaDir, hex.EncodeToString(mac.Sum(nil))+ExtensionForMimeType(mimeType))
+}
+
+// CleanupExpiredFiles removes published media files older than the link TTL
+func (s *Store) CleanupExpiredFiles() error {
+	if s.linkTTL <= 0 {
+		return nil
+	}
+
+	dir := filepath.Join(s.staticDir, publishedMediaDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read published media directory: %w", err)
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		// Remove if older than the link TTL
+		if now.Sub(info.ModTime()) > s.linkTTL {
+			filePath := filepath.Join(dir, entry.Name())
+			if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+				log.Printf("Failed to cleanup expired media file %s: %v", filePath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// StartPeriodicCleanup starts a background goroutine to periodically remove expired published media
+func (s *Store) StartPeriodicCleanup(interval time.Duration) {
+	if s.linkTTL <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := s.CleanupExpiredFiles(); err != nil {
+				log.Printf("Warning: media cleanup failed: %v", err)
+			}
+		}
+	}()
+}
+
+// ReadMediaFile reads a previously cached media file, returning nil if it no longer exists
+func (s *Store) ReadMediaFile(filePath string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cached media file: %w", err)
+	}
+	return data, nil
+}
+
+// URLForPath returns the static URL of a file inside the static directory
+func (s *Store) URLForPath(filePath string) string {
+	relPath, err := filepath.Rel(s.staticDir, filePath)
+	if err != nil {
+		relPath = filepath.Base(filePath)
+	}
+	return fmt.Sprintf("%s/%s", s.baseURL, filepath.ToSlash(relPath))
+}
+
+// newFile creates a File and detects its MIME type from content
+func newFile(data []byte, filename string) *File {
+	return &File{
+		Data:     data,
+		Filename: filename,
+		MimeType: DetectMimeType(data),
+	}
+}
+
+// DetectMimeType sniffs the MIME type of the given content
+func DetectMimeType(data []byte) string {
+	mimeType := http.DetectContentType(data)
+	// Drop parameters such as "; charset=utf-8"
+	if idx := strings.Index(mimeType, ";"); idx != -1 {
+		mimeType = strings.TrimSpace(mimeType[:idx])
+	}
+	return mimeType
+}
@@ -0,0 +1,90 @@
+package media
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPublishMediaFileKeepsLinksAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+	staticDir, cacheDir := filepath.Join(dir, "static"), filepath.Join(dir, "media-cache")
+
+	first, err := NewStore(staticDir, "", cacheDir, "http://localhost/static", "", DefaultLinkTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstURL, err := first.PublishMediaFile("3EB0ABCDEF", "image/jpeg", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := NewStore(staticDir, "", cacheDir, "http://localhost/static", "", DefaultLinkTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondURL, err := second.PublishMediaFile("3EB0ABCDEF", "image/jpeg", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if firstURL != secondURL {
+		t.Fatalf("expected the same link after a restart, got %s and %s", firstURL, secondURL)
+	}
+}
+
+func TestRemoveMediaFilesUnpublishes(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(filepath.Join(dir, "static"), "", filepath.Join(dir, "media-cache"), "http://localhost/static", "key", DefaultLinkTTL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localPath, err := store.SaveMediaFile("3EB0ABCDEF", "image/jpeg", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.PublishMediaFile("3EB0ABCDEF", "image/jpeg", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.RemoveMediaFiles("3EB0ABCDEF", "image/jpeg", localPath); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{localPath, store.publishedPath("3EB0ABCDEF", "image/jpeg")} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed", path)
+		}
+	}
+}
+
+func TestCleanupExpiredFilesRemovesOldLinks(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewStore(filepath.Join(dir, "static"), "", filepath.Join(dir, "media-cache"), "http://localhost/static", "key", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.PublishMediaFile("old", "image/jpeg", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.PublishMediaFile("new", "image/jpeg", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	old := store.publishedPath("old", "image/jpeg")
+	expired := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(old, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.CleanupExpiredFiles(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("expected the expired link to be removed")
+	}
+	if _, err := os.Stat(store.publishedPath("new", "image/jpeg")); err != nil {
+		t.Errorf("expected the current link to be kept: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package media
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// VideoInfo contains the dimensions and duration of an MP4 video
+type VideoInfo struct {
+	Width           int
+	Height          int
+	DurationSeconds uint32
+}
+
+// ParseMP4 validates that data is an MP4 container and reads its dimensions and duration
+func ParseMP4(data []byte) (*VideoInfo, error) {
+	if len(data) < 12 || string(data[4:8]) != "ftyp" {
+		return nil, fmt.Errorf("video is not an MP4 container (detected %s)", DetectMimeType(data))
+	}
+
+	moov := findBox(data, "moov")
+	if moov == nil {
+		return nil, fmt.Errorf("MP4 file has no movie header (moov box)")
+	}
+
+	info := &VideoInfo{}
+
+	// Movie header holds the overall duration
+	if mvhd := findBox(moov, "mvhd"); len(mvhd) >= 32 {
+		var timescale, duration uint64
+		if mvhd[0] == 1 {
+			timescale = uint64(binary.BigEndian.Uint32(mvhd[20:24]))
+			duration = binary.BigEndian.Uint64(mvhd[24:32])
+		} else {
+			timescale = uint64(binary.BigEndian.Uint32(mvhd[12:16]))
+			duration = uint64(binary.BigEndian.Uint32(mvhd[16:20]))
+		}
+		if timescale > 0 {
+			info.DurationSeconds = uint32((duration + timescale - 1) / timescale)
+		}
+	}
+
+	// Track headers hold the presentation size, audio tracks have zero width and height
+	forEachBox(moov, func(boxType string, content []byte) {
+		if boxType != "trak" || info.Width > 0 {
+			return
+		}
+		tkhd := findBox(content, "tkhd")
+		widthOffset := 76
+		if len(tkhd) > 0 && tkhd[0] == 1 {
+			widthOffset = 88
+		}
+		if len(tkhd) < widthOffset+8 {
+			return
+		}
+		// Width and height are 16.16 fixed point numbers
+		info.Width = int(binary.BigEndian.Uint32(tkhd[widthOffset:widthOffset+4]) >> 16)
+		info.Height = int(binary.BigEndian.Uint32(tkhd[widthOffset+4:widthOffset+8]) >> 16)
+	})
+
+	return info, nil
+}
+
+// forEachBox calls fn for every top level box in data
+func forEachBox(data []byte, fn func(boxType string, content []byte)) {
+	for offset := 0; offset+8 <= len(data); {
+		size := uint64(binary.BigEndian.Uint32(data[offset : offset+4]))
+		boxType := string(data[offset+4 : offset+8])
+		headerSize := uint64(8)
+
+		switch size {
+		case 0:
+			// Box extends to the end of the data
+			size = uint64(len(data) - offset)
+		case 1:
+			// 64-bit extended size follows the type
+			if offset+16 > len(data) {
+				return
+			}
+			size = binary.BigEndian.Uint64(data[offset+8 : offset+16])
+			headerSize = 16
+		}
+
+		// Compare against the remaining length so a huge 64-bit size cannot overflow the bound
+		if size < headerSize || size > uint64(len(data)-offset) {
+			return
+		}
+
+		fn(boxType, data[offset+int(headerSize):offset+int(size)])
+		offset += int(size)
+	}
+}
+
+// findBox returns the content of the first top level box of the given type in data, or nil if not found
+func findBox(data []byte, boxType string) []byte {
+	var found []byte
+	forEachBox(data, func(t string, content []byte) {
+		if found == nil && t == boxType {
+			found = content
+		}
+	})
+	return found
+}
+
+// PlaceholderThumbnail generates a JPEG thumbnail with a play symbol in the given aspect ratio,
+// used for videos when no thumbnail image is provided since frames cannot be decoded without a codec
+func PlaceholderThumbnail(width, height int) ([]byte, error) {
+	if width <= 0 || height <= 0 {
+		width, height = 16, 9
+	}
+
+	// Scale to the thumbnail size, keeping aspect ratio
+	w, h := ThumbnailSize, ThumbnailSize
+	if width >= height {
+		h = max(1, height*ThumbnailSize/width)
+	} else {
+		w = max(1, width*ThumbnailSize/height)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	background := color.RGBA{R: 32, G: 32, B: 32, A: 255}
+	foreground := color.RGBA{R: 230, G: 230, B: 230, A: 255}
+
+	// Play triangle centered in the frame
+	size := min(w, h) / 3
+	cx, cy := w/2, h/2
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dx, dy := x-(cx-size/2), y-cy
+			if dx >= 0 && dx <= size && 2*abs(dy) <= size-dx {
+				img.Set(x, y, foreground)
+			} else {
+				img.Set(x, y, background)
+			}
+		}
+	}
+
+	return GenerateThumbnail(img, ThumbnailSize)
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,38 @@
+package media
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestForEachBoxRejectsOverflowingLargeSize(t *testing.T) {
+	// A 64-bit box whose largesize wraps around when added to the offset
+	box := make([]byte, 16)
+	binary.BigEndian.PutUint32(box[0:4], 1)
+	copy(box[4:8], "moov")
+	binary.BigEndian.PutUint64(box[8:16], math.MaxUint64-7)
+
+	data := append([]byte{0, 0, 0, 8, 'f', 'r', 'e', 'e'}, box...)
+
+	var seen []string
+	forEachBox(data, func(boxType string, content []byte) {
+		seen = append(seen, boxType)
+	})
+	if len(seen) != 1 || seen[0] != "free" {
+		t.Fatalf("expected only the free box, got %v", seen)
+	}
+}
+
+func TestParseMP4RejectsOverflowingLargeSize(t *testing.T) {
+	data := []byte{0, 0, 0, 12, 'f', 't', 'y', 'p', 'i', 's', 'o', 'm'}
+	box := make([]byte, 16)
+	binary.BigEndian.PutUint32(box[0:4], 1)
+	copy(box[4:8], "moov")
+	binary.BigEndian.PutUint64(box[8:16], math.MaxUint64)
+	data = append(data, box...)
+
+	if _, err := ParseMP4(data); err == nil {
+		t.Fatal("expected an error for a truncated moov box")
+	}
+}
@@ -5,6 +5,281 @@ type SendMessageParams struct {
 	To              string `json:"to" description:"WhatsApp JID of recipient. For phone numbers: 'phonenumber@s.whatsapp.net' (e.g. '1234567890@s.whatsapp.net'). For groups: 'groupid@g.us'"`
 	Text            string `json:"text" description:"Text content of the message to send (plain text, no formatting)"`
 	QuotedMessageID string `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to. Use message ID from previous chat history to quote/reply to that message"`
+	SimulateTyping  bool   `json:"simulate_typing,omitempty" description:"Show the typing indicator for a time proportional to the text length before sending"`
+}
+
+// SendImageMessageParams represents parameters for sending an image message
+type SendImageMessageParams struct {
+	To              string `json:"to" description:"WhatsApp JID of recipient. For phone numbers: 'phonenumber@s.whatsapp.net'. For groups: 'groupid@g.us'"`
+	ImageBase64     string `json:"image_base64,omitempty" description:"Base64 encoded image content (JPEG or PNG), optionally as a data URI"`
+	ImagePath       string `json:"image_path,omitempty" description:"Path to an image file on the server filesystem"`
+	ImageURL        string `json:"image_url,omitempty" description:"URL of an image served from this server's /static endpoint"`
+	Caption         string `json:"caption,omitempty" description:"Optional caption displayed under the image"`
+	QuotedMessageID string `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// SendDocumentMessageParams represents parameters for sending a document message
+type SendDocumentMessageParams struct {
+	To              string `json:"to" description:"WhatsApp JID of recipient. For phone numbers: 'phonenumber@s.whatsapp.net'. For groups: 'groupid@g.us'"`
+	DocumentBase64  string `json:"document_base64,omitempty" description:"Base64 encoded file content, optionally as a data URI"`
+	DocumentPath    string `json:"document_path,omitempty" description:"Path to a file on the server filesystem"`
+	DocumentURL     string `json:"document_url,omitempty" description:"URL of a file served from this server's /static endpoint"`
+	Filename        string `json:"filename,omitempty" description:"Optional filename shown to the recipient"`
+	MimeType        string `json:"mimetype,omitempty" description:"Optional MIME type. Detected from content and filename if omitted"`
+	Caption         string `json:"caption,omitempty" description:"Optional caption displayed with the document"`
+	QuotedMessageID string `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// SendAudioMessageParams represents parameters for sending an audio message or voice note
+type SendAudioMessageParams struct {
+	To              string `json:"to" description:"WhatsApp JID of recipient. For phone numbers: 'phonenumber@s.whatsapp.net'. For groups: 'groupid@g.us'"`
+	AudioBase64     string `json:"audio_base64,omitempty" description:"Base64 encoded audio content, optionally as a data URI"`
+	AudioPath       string `json:"audio_path,omitempty" description:"Path to an audio file on the server filesystem"`
+	AudioURL        string `json:"audio_url,omitempty" description:"URL of an audio file served from this server's /static endpoint"`
+	PTT             bool   `json:"ptt,omitempty" description:"Send as a push-to-talk voice note. Requires OGG/Opus audio"`
+	QuotedMessageID string `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// SendVideoMessageParams represents parameters for sending a video or GIF message
+type SendVideoMessageParams struct {
+	To              string `json:"to" description:"WhatsApp JID of recipient. For phone numbers: 'phonenumber@s.whatsapp.net'. For groups: 'groupid@g.us'"`
+	VideoBase64     string `json:"video_base64,omitempty" description:"Base64 encoded MP4 video content, optionally as a data URI"`
+	VideoPath       string `json:"video_path,omitempty" description:"Path to an MP4 video file on the server filesystem"`
+	VideoURL        string `json:"video_url,omitempty" description:"URL of an MP4 video served from this server's /static endpoint"`
+	ThumbnailBase64 string `json:"thumbnail_base64,omitempty" description:"Optional base64 encoded JPEG/PNG frame used to generate the thumbnail"`
+	ThumbnailPath   string `json:"thumbnail_path,omitempty" description:"Optional path to a JPEG/PNG frame used to generate the thumbnail"`
+	Caption         string `json:"caption,omitempty" description:"Optional caption displayed under the video"`
+	GifPlayback     bool   `json:"gif_playback,omitempty" description:"Play the video as a looping, muted GIF"`
+	QuotedMessageID string `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// SendLocationMessageParams represents parameters for sending a location message
+type SendLocationMessageParams struct {
+	To              string   `json:"to" description:"WhatsApp JID (recipient identifier) to send the location to"`
+	Latitude        *float64 `json:"latitude" description:"Latitude in degrees (-90 to 90)"`
+	Longitude       *float64 `json:"longitude" description:"Longitude in degrees (-180 to 180)"`
+	Name            string   `json:"name,omitempty" description:"Optional name of the place"`
+	Address         string   `json:"address,omitempty" description:"Optional address of the place"`
+	QuotedMessageID string   `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// SendContactMessageParams represents parameters for sending one or more contact cards
+type SendContactMessageParams struct {
+	To              string         `json:"to" description:"WhatsApp JID (recipient identifier) to send the contacts to"`
+	Contacts        []ContactInput `json:"contacts" description:"Contacts to share, each built from fields or given as a raw vCard"`
+	QuotedMessageID string         `json:"quoted_message_id,omitempty" description:"Optional message ID to reply to"`
+}
+
+// ContactInput represents a single contact to share
+type ContactInput struct {
+	Name         string `json:"name,omitempty" description:"Full name of the contact"`
+	Phone        string `json:"phone,omitempty" description:"Phone number in international format (e.g., +1234567890)"`
+	Email        string `json:"email,omitempty" description:"Email address"`
+	Organization string `json:"organization,omitempty" description:"Company or organization"`
+	VCard        string `json:"vcard,omitempty" description:"Raw vCard string, used instead of the other fields"`
+}
+
+// SendReactionParams represents parameters for reacting to a message
+type SendReactionParams struct {
+	Chat      string `json:"chat" description:"WhatsApp JID of the chat containing the message"`
+	MessageID string `json:"message_id" description:"ID of the message to react to"`
+	Emoji     string `json:"emoji,omitempty" description:"Emoji to react with (e.g., 👍)"`
+	Remove    bool   `json:"remove,omitempty" description:"Remove our existing reaction instead of reacting"`
+	Sender    string `json:"sender,omitempty" description:"Optional JID of the message author, looked up from history if omitted"`
+}
+
+// EditMessageParams represents parameters for editing a sent message
+type EditMessageParams struct {
+	Chat      string `json:"chat" description:"WhatsApp JID of the chat containing the message"`
+	MessageID string `json:"message_id" description:"ID of our own text message to edit"`
+	Text      string `json:"text" description:"New message text"`
+}
+
+// DeleteMessageParams represents parameters for deleting a message for everyone
+type DeleteMessageParams struct {
+	Chat      string `json:"chat" description:"WhatsApp JID of the chat containing the message"`
+	MessageID string `json:"message_id" description:"ID of the message to delete"`
+	Sender    string `json:"sender,omitempty" description:"Optional JID of the message author, looked up from history if omitted"`
+}
+
+// CreatePollParams represents parameters for creating a poll
+type CreatePollParams struct {
+	To              string   `json:"to" description:"WhatsApp JID (recipient identifier) to send the poll to"`
+	Question        string   `json:"question" description:"Poll question"`
+	Options         []string `json:"options" description:"Poll options (2 to 12 unique values)"`
+	SelectableCount *int     `json:"selectable_count,omitempty" description:"Maximum number of options a voter may select (default: 1, 0 for any number)"`
+}
+
+// GetPollResultsParams represents parameters for retrieving poll results
+type GetPollResultsParams struct {
+	MessageID string `json:"message_id" description:"ID of the poll message"`
+}
+
+// CreateGroupParams represents parameters for creating a group
+type CreateGroupParams struct {
+	Name         string   `json:"name" description:"Group name (subject)"`
+	Participants []string `json:"participants" description:"JIDs of the participants to add"`
+	Description  string   `json:"description,omitempty" description:"Optional group description"`
+}
+
+// LeaveGroupParams represents parameters for leaving a group
+type LeaveGroupParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group to leave"`
+}
+
+// SetGroupNameParams represents parameters for renaming a group
+type SetGroupNameParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group"`
+	Name     string `json:"name" description:"New group name"`
+}
+
+// SetGroupDescriptionParams represents parameters for changing a group description
+type SetGroupDescriptionParams struct {
+	GroupJID    string `json:"group_jid" description:"JID of the group"`
+	Description string `json:"description" description:"New group description, empty to remove it"`
+}
+
+// SetGroupPhotoParams represents parameters for changing a group photo
+type SetGroupPhotoParams struct {
+	GroupJID    string `json:"group_jid" description:"JID of the group"`
+	ImageBase64 string `json:"image_base64,omitempty" description:"Base64 encoded image content (JPEG or PNG), optionally as a data URI"`
+	ImagePath   string `json:"image_path,omitempty" description:"Path to an image file on the server filesystem"`
+	ImageURL    string `json:"image_url,omitempty" description:"URL of an image served from this server's /static endpoint"`
+	Remove      bool   `json:"remove,omitempty" description:"Remove the current group photo instead of setting a new one"`
+}
+
+// GetGroupInfoParams represents parameters for retrieving group information
+type GetGroupInfoParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group"`
+}
+
+// UpdateGroupParticipantsParams represents parameters for changing group participants
+type UpdateGroupParticipantsParams struct {
+	GroupJID string   `json:"group_jid" description:"JID of the group"`
+	Add      []string `json:"add,omitempty" description:"JIDs of participants to add"`
+	Remove   []string `json:"remove,omitempty" description:"JIDs of participants to remove"`
+	Promote  []string `json:"promote,omitempty" description:"JIDs of participants to make admin"`
+	Demote   []string `json:"demote,omitempty" description:"JIDs of admins to make regular participants"`
+}
+
+// GetGroupInviteLinkParams represents parameters for getting or resetting a group invite link
+type GetGroupInviteLinkParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group"`
+	Reset    bool   `json:"reset,omitempty" description:"Revoke the current link and create a new one"`
+}
+
+// PreviewGroupInviteParams represents parameters for previewing a group before joining it
+type PreviewGroupInviteParams struct {
+	InviteLink string `json:"invite_link,omitempty" description:"Group invite link (https://chat.whatsapp.com/...) or invite code"`
+	MessageID  string `json:"message_id,omitempty" description:"ID of a received group invite message"`
+}
+
+// JoinGroupWithLinkParams represents parameters for joining a group via invite link
+type JoinGroupWithLinkParams struct {
+	InviteLink string `json:"invite_link" description:"Group invite link (https://chat.whatsapp.com/...) or invite code"`
+}
+
+// JoinGroupWithInviteParams represents parameters for joining a group via a received invite message
+type JoinGroupWithInviteParams struct {
+	MessageID string `json:"message_id" description:"ID of a received group invite message"`
+}
+
+// GetGroupJoinRequestsParams represents parameters for listing pending join requests of a group
+type GetGroupJoinRequestsParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group"`
+}
+
+// UpdateGroupJoinRequestsParams represents parameters for approving or rejecting join requests
+type UpdateGroupJoinRequestsParams struct {
+	GroupJID string   `json:"group_jid" description:"JID of the group"`
+	Approve  []string `json:"approve,omitempty" description:"JIDs of requesters to let into the group"`
+	Reject   []string `json:"reject,omitempty" description:"JIDs of requesters to turn down"`
+}
+
+// SetGroupJoinApprovalParams represents parameters for changing the join approval mode of a group
+type SetGroupJoinApprovalParams struct {
+	GroupJID string `json:"group_jid" description:"JID of the group"`
+	Enabled  *bool  `json:"enabled" description:"Whether new members need admin approval to join"`
+}
+
+// CreateCommunityParams represents parameters for creating a community
+type CreateCommunityParams struct {
+	Name         string `json:"name" description:"Name of the community"`
+	Description  string `json:"description,omitempty" description:"Optional description of the community"`
+	JoinApproval bool   `json:"join_approval,omitempty" description:"Whether new members need admin approval to join"`
+}
+
+// GetCommunitySubGroupsParams represents parameters for listing the groups of a community
+type GetCommunitySubGroupsParams struct {
+	CommunityJID string `json:"community_jid" description:"JID of the community"`
+}
+
+// UpdateCommunityGroupsParams represents parameters for linking groups to and unlinking groups from a community
+type UpdateCommunityGroupsParams struct {
+	CommunityJID string   `json:"community_jid" description:"JID of the community"`
+	Link         []string `json:"link,omitempty" description:"JIDs of existing groups to add to the community"`
+	Unlink       []string `json:"unlink,omitempty" description:"JIDs of groups to remove from the community"`
+}
+
+// SendCommunityAnnouncementParams represents parameters for posting to the announcement group of a community
+type SendCommunityAnnouncementParams struct {
+	CommunityJID string `json:"community_jid" description:"JID of the community"`
+	Text         string `json:"text" description:"Text of the announcement"`
+}
+
+// GetUserInfoParams represents parameters for looking up users
+type GetUserInfoParams struct {
+	JIDs    []string `json:"jids" description:"JIDs or phone numbers of the users to look up"`
+	Refresh bool     `json:"refresh,omitempty" description:"Query WhatsApp even if a cached result is available"`
+}
+
+// GetProfilePictureInfoParams represents parameters for looking up a profile picture
+type GetProfilePictureInfoParams struct {
+	JID      string `json:"jid" description:"JID or phone number of the user, or JID of a group"`
+	Preview  bool   `json:"preview,omitempty" description:"Get the low resolution preview instead of the full picture"`
+	Download bool   `json:"download,omitempty" description:"Download the picture and return it as an image"`
+	Refresh  bool   `json:"refresh,omitempty" description:"Query WhatsApp even if a cached result is available"`
+}
+
+// GetBusinessProfileParams represents parameters for looking up a business profile
+type GetBusinessProfileParams struct {
+	JID     string `json:"jid" description:"JID or phone number of the business account"`
+	Refresh bool   `json:"refresh,omitempty" description:"Query WhatsApp even if a cached result is available"`
+}
+
+// GetBusinessCatalogParams represents parameters for listing the products of a business catalog
+type GetBusinessCatalogParams struct {
+	JID    string `json:"jid" description:"JID or phone number of the business account"`
+	Count  int    `json:"count,omitempty" description:"Maximum number of products to return (default: 20, max: 100)"`
+	Cursor string `json:"cursor,omitempty" description:"Cursor of the next page returned by a previous call"`
+}
+
+// GetBusinessCollectionsParams represents parameters for listing the collections of a business catalog
+type GetBusinessCollectionsParams struct {
+	JID   string `json:"jid" description:"JID or phone number of the business account"`
+	Count int    `json:"count,omitempty" description:"Maximum number of collections and products per collection (default: 20, max: 100)"`
+}
+
+// SendPresenceParams represents parameters for setting our global presence
+type SendPresenceParams struct {
+	Presence string `json:"presence" description:"Presence to set: available or unavailable"`
+}
+
+// SendChatPresenceParams represents parameters for showing a typing or recording indicator in a chat
+type SendChatPresenceParams struct {
+	JID   string `json:"jid" description:"WhatsApp JID of the chat"`
+	State string `json:"state" description:"Chat state to show: composing, recording or paused"`
+}
+
+// SubscribePresenceParams represents parameters for subscribing to the presence of a user
+type SubscribePresenceParams struct {
+	JID string `json:"jid" description:"JID or phone number of the user"`
+}
+
+// DownloadMediaParams represents parameters for downloading media from a message
+type DownloadMediaParams struct {
+	MessageID      string `json:"message_id" description:"ID of an image, video, audio, sticker or document message"`
+	IncludeDeleted bool   `json:"include_deleted,omitempty" description:"Return the media of messages deleted for everyone"`
 }
 
 // IsOnWhatsappParams represents parameters for checking WhatsApp registration status
@@ -17,6 +292,7 @@ type GetChatHistoryParams struct {
 	Chat            string `json:"chat" description:"WhatsApp JID (chat identifier) to retrieve messages from"`
 	Count           int    `json:"count,omitempty" description:"Maximum number of messages to retrieve (default: 50, max: 100)"`
 	BeforeMessageID string `json:"before_message_id,omitempty" description:"Optional message ID to retrieve messages before this point (for pagination)"`
+	IncludeDeleted  bool   `json:"include_deleted,omitempty" description:"Return the original content of messages deleted for everyone"`
 }
 
 // GetUnreadMessagesParams represents parameters for retrieving unread messages
@@ -25,6 +301,75 @@ type GetUnreadMessagesParams struct {
 	Count int    `json:"count,omitempty" description:"Maximum number of unread messages to retrieve (default: 50, max: 100)"`
 }
 
+// GetContactsParams represents parameters for listing contacts
+type GetContactsParams struct {
+	Query string `json:"query,omitempty" description:"Optional search for part of a name or phone number"`
+	Count int    `json:"count,omitempty" description:"Maximum number of contacts to retrieve (default: 50, max: 500)"`
+}
+
+// GetAllChatsParams represents parameters for listing chats
+type GetAllChatsParams struct {
+	Type   string `json:"type,omitempty" description:"Only list chats of this type: direct, group or broadcast"`
+	SortBy string `json:"sort_by,omitempty" description:"Sort order: recent (default, pinned chats first), unread or name"`
+	Label  string `json:"label,omitempty" description:"Only list chats with the label of this ID"`
+	Count  int    `json:"count,omitempty" description:"Maximum number of chats to retrieve (default: 50, max: 500)"`
+	Offset int    `json:"offset,omitempty" description:"Number of chats to skip, for pagination"`
+}
+
+// ArchiveChatParams represents parameters for archiving or unarchiving a chat
+type ArchiveChatParams struct {
+	JID      string `json:"jid" description:"WhatsApp JID of the chat"`
+	Archived *bool  `json:"archived" description:"true to archive the chat, false to unarchive it"`
+}
+
+// PinChatParams represents parameters for pinning or unpinning a chat
+type PinChatParams struct {
+	JID    string `json:"jid" description:"WhatsApp JID of the chat"`
+	Pinned *bool  `json:"pinned" description:"true to pin the chat, false to unpin it"`
+}
+
+// MuteChatParams represents parameters for muting or unmuting a chat
+type MuteChatParams struct {
+	JID           string  `json:"jid" description:"WhatsApp JID of the chat"`
+	Muted         *bool   `json:"muted" description:"true to mute the chat, false to unmute it"`
+	DurationHours float64 `json:"duration_hours,omitempty" description:"How long to mute the chat in hours (default: forever)"`
+}
+
+// MarkChatUnreadParams represents parameters for marking a chat as unread or read
+type MarkChatUnreadParams struct {
+	JID    string `json:"jid" description:"WhatsApp JID of the chat"`
+	Unread *bool  `json:"unread" description:"true to mark the chat as unread, false to mark it as read"`
+}
+
+// CreateLabelParams represents parameters for creating a label
+type CreateLabelParams struct {
+	Name  string `json:"name" description:"Name of the label"`
+	Color *int   `json:"color,omitempty" description:"Index of the label color in the WhatsApp color palette, 0 to 19"`
+}
+
+// EditLabelParams represents parameters for renaming, recoloring or deleting a label
+type EditLabelParams struct {
+	LabelID string `json:"label_id" description:"ID of the label"`
+	Name    string `json:"name,omitempty" description:"New name of the label"`
+	Color   *int   `json:"color,omitempty" description:"New color of the label, 0 to 19"`
+	Delete  bool   `json:"delete,omitempty" description:"Delete the label, removing it from all chats and messages"`
+}
+
+// LabelChatParams represents parameters for adding a label to or removing it from a chat
+type LabelChatParams struct {
+	JID     string `json:"jid" description:"WhatsApp JID of the chat"`
+	LabelID string `json:"label_id" description:"ID of the label"`
+	Labeled *bool  `json:"labeled" description:"true to add the label, false to remove it"`
+}
+
+// LabelMessageParams represents parameters for adding a label to or removing it from a message
+type LabelMessageParams struct {
+	Chat      string `json:"chat" description:"WhatsApp JID of the chat containing the message"`
+	MessageID string `json:"message_id" description:"ID of the message"`
+	LabelID   string `json:"label_id" description:"ID of the label"`
+	Labeled   *bool  `json:"labeled" description:"true to add the label, false to remove it"`
+}
+
 // MarkMessagesAsReadParams represents parameters for marking messages as read
 type MarkMessagesAsReadParams struct {
 	Chat string `json:"chat" description:"WhatsApp JID (chat identifier) to mark messages as read in this chat"`
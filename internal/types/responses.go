@@ -38,6 +38,539 @@ type MessageResponse struct {
 	To              string `json:"to"`
 	Text            string `json:"text"`
 	QuotedMessageID string `json:"quoted_message_id,omitempty"`
+	TypingMs        int64  `json:"typing_ms,omitempty"`
+}
+
+// MediaMessageResponse represents the response for media message sending
+type MediaMessageResponse struct {
+	MessageID       string `json:"message_id"`
+	Timestamp       int64  `json:"timestamp"`
+	Success         bool   `json:"success"`
+	To              string `json:"to"`
+	MessageType     string `json:"message_type"`
+	MimeType        string `json:"mimetype"`
+	FileLength      uint64 `json:"file_length"`
+	FileName        string `json:"filename,omitempty"`
+	PageCount       int    `json:"page_count,omitempty"`
+	DurationSeconds uint32 `json:"duration_seconds,omitempty"`
+	PTT             bool   `json:"ptt,omitempty"`
+	GifPlayback     bool   `json:"gif_playback,omitempty"`
+	Caption         string `json:"caption,omitempty"`
+	QuotedMessageID string `json:"quoted_message_id,omitempty"`
+}
+
+// DownloadMediaResponse represents the response for a media download
+type DownloadMediaResponse struct {
+	Success     bool   `json:"success"`
+	MessageID   string `json:"message_id"`
+	MessageType string `json:"message_type"`
+	MimeType    string `json:"mimetype"`
+	FileName    string `json:"filename,omitempty"`
+	FileLength  uint64 `json:"file_length"`
+	URL         string `json:"url,omitempty"`
+	Cached      bool   `json:"cached"`
+}
+
+// LocationMessageResponse represents the response for sending a location message
+type LocationMessageResponse struct {
+	MessageID       string  `json:"message_id"`
+	Timestamp       int64   `json:"timestamp"`
+	Success         bool    `json:"success"`
+	To              string  `json:"to"`
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	Name            string  `json:"name,omitempty"`
+	Address         string  `json:"address,omitempty"`
+	QuotedMessageID string  `json:"quoted_message_id,omitempty"`
+}
+
+// ContactMessageResponse represents the response for sending a contact message
+type ContactMessageResponse struct {
+	MessageID       string        `json:"message_id"`
+	Timestamp       int64         `json:"timestamp"`
+	Success         bool          `json:"success"`
+	To              string        `json:"to"`
+	Contacts        []ContactCard `json:"contacts"`
+	QuotedMessageID string        `json:"quoted_message_id,omitempty"`
+}
+
+// ReactionResponse represents the response for sending or removing a reaction
+type ReactionResponse struct {
+	MessageID       string `json:"message_id"`
+	Timestamp       int64  `json:"timestamp"`
+	Success         bool   `json:"success"`
+	Chat            string `json:"chat"`
+	TargetMessageID string `json:"target_message_id"`
+	Emoji           string `json:"emoji"`
+	Removed         bool   `json:"removed"`
+}
+
+// EditMessageResponse represents the response for editing a message
+type EditMessageResponse struct {
+	MessageID       string `json:"message_id"`
+	Timestamp       int64  `json:"timestamp"`
+	Success         bool   `json:"success"`
+	Chat            string `json:"chat"`
+	TargetMessageID string `json:"target_message_id"`
+	Text            string `json:"text"`
+	PreviousText    string `json:"previous_text"`
+}
+
+// DeleteMessageResponse represents the response for deleting a message for everyone
+type DeleteMessageResponse struct {
+	MessageID       string `json:"message_id"`
+	Timestamp       int64  `json:"timestamp"`
+	Success         bool   `json:"success"`
+	Chat            string `json:"chat"`
+	TargetMessageID string `json:"target_message_id"`
+	AdminRevoke     bool   `json:"admin_revoke"`
+}
+
+// PollMessageResponse represents the response for creating a poll
+type PollMessageResponse struct {
+	MessageID       string   `json:"message_id"`
+	Timestamp       int64    `json:"timestamp"`
+	Success         bool     `json:"success"`
+	To              string   `json:"to"`
+	Question        string   `json:"question"`
+	Options         []string `json:"options"`
+	SelectableCount int      `json:"selectable_count"`
+}
+
+// PollResultsResponse represents the current tally of a poll
+type PollResultsResponse struct {
+	Success         bool               `json:"success"`
+	MessageID       string             `json:"message_id"`
+	Chat            string             `json:"chat"`
+	Question        string             `json:"question"`
+	SelectableCount int                `json:"selectable_count"`
+	Options         []PollOptionResult `json:"options"`
+	TotalVoters     int                `json:"total_voters"`
+}
+
+// PollOptionResult represents the votes for a single poll option
+type PollOptionResult struct {
+	Name   string   `json:"name"`
+	Votes  int      `json:"votes"`
+	Voters []string `json:"voters"`
+}
+
+// GroupParticipantResult represents the outcome of adding or changing a single group participant
+type GroupParticipantResult struct {
+	JID         string `json:"jid"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	Action      string `json:"action,omitempty"`
+	Success     bool   `json:"success"`
+	ErrorCode   int    `json:"error_code,omitempty"`
+	Error       string `json:"error,omitempty"`
+	// Participants whose privacy settings block adding them can be invited with this code instead
+	AddRequestCode       string `json:"add_request_code,omitempty"`
+	AddRequestExpiration int64  `json:"add_request_expiration,omitempty"`
+}
+
+// CreateGroupResponse represents the response for creating a group
+type CreateGroupResponse struct {
+	Success      bool                     `json:"success"`
+	GroupJID     string                   `json:"group_jid"`
+	Name         string                   `json:"name"`
+	Description  string                   `json:"description,omitempty"`
+	CreatedAt    int64                    `json:"created_at"`
+	Participants []GroupParticipantResult `json:"participants"`
+}
+
+// GroupUpdateResponse represents the response for leaving a group or changing its name or description
+type GroupUpdateResponse struct {
+	Success     bool   `json:"success"`
+	GroupJID    string `json:"group_jid"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GroupPhotoResponse represents the response for changing a group photo
+type GroupPhotoResponse struct {
+	Success   bool   `json:"success"`
+	GroupJID  string `json:"group_jid"`
+	PictureID string `json:"picture_id,omitempty"`
+	Removed   bool   `json:"removed"`
+}
+
+// GroupInfoResponse represents the metadata, settings and participants of a group
+type GroupInfoResponse struct {
+	Success              bool               `json:"success"`
+	GroupJID             string             `json:"group_jid"`
+	Name                 string             `json:"name"`
+	NameSetAt            int64              `json:"name_set_at,omitempty"`
+	NameSetBy            string             `json:"name_set_by,omitempty"`
+	Topic                string             `json:"topic,omitempty"`
+	TopicSetAt           int64              `json:"topic_set_at,omitempty"`
+	TopicSetBy           string             `json:"topic_set_by,omitempty"`
+	Owner                string             `json:"owner,omitempty"`
+	CreatedAt            int64              `json:"created_at"`
+	Announce             bool               `json:"announce"`
+	Locked               bool               `json:"locked"`
+	JoinApprovalRequired bool               `json:"join_approval_required"`
+	MemberAddMode        string             `json:"member_add_mode,omitempty"`
+	DisappearingTimer    uint32             `json:"disappearing_timer,omitempty"`
+	IsCommunity          bool               `json:"is_community"`
+	LinkedParentJID      string             `json:"linked_parent_jid,omitempty"`
+	ParticipantCount     int                `json:"participant_count"`
+	Participants         []GroupParticipant `json:"participants"`
+}
+
+// GroupParticipant represents a member of a group
+type GroupParticipant struct {
+	JID          string `json:"jid"`
+	PhoneNumber  string `json:"phone_number,omitempty"`
+	LID          string `json:"lid,omitempty"`
+	IsAdmin      bool   `json:"is_admin"`
+	IsSuperAdmin bool   `json:"is_super_admin"`
+	DisplayName  string `json:"display_name,omitempty"`
+}
+
+// UpdateGroupParticipantsResponse represents the response for changing group participants
+type UpdateGroupParticipantsResponse struct {
+	Success   bool                     `json:"success"`
+	GroupJID  string                   `json:"group_jid"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Results   []GroupParticipantResult `json:"results"`
+}
+
+// GroupInviteLinkResponse represents the response for getting or resetting a group invite link
+type GroupInviteLinkResponse struct {
+	Success    bool   `json:"success"`
+	GroupJID   string `json:"group_jid"`
+	InviteLink string `json:"invite_link"`
+	InviteCode string `json:"invite_code"`
+	Reset      bool   `json:"reset"`
+}
+
+// JoinGroupResponse represents the response for joining a group via invite link or invite message
+type JoinGroupResponse struct {
+	Success         bool   `json:"success"`
+	GroupJID        string `json:"group_jid"`
+	Name            string `json:"name,omitempty"`
+	PendingApproval bool   `json:"pending_approval"`
+}
+
+// GroupJoinRequest represents a pending request to join a group
+type GroupJoinRequest struct {
+	JID         string `json:"jid"`
+	RequestedAt int64  `json:"requested_at"`
+}
+
+// GroupJoinRequestsResponse represents the response for listing pending join requests of a group
+type GroupJoinRequestsResponse struct {
+	Success  bool               `json:"success"`
+	GroupJID string             `json:"group_jid"`
+	Count    int                `json:"count"`
+	Requests []GroupJoinRequest `json:"requests"`
+}
+
+// GroupJoinApprovalResponse represents the response for changing the join approval mode of a group
+type GroupJoinApprovalResponse struct {
+	Success              bool   `json:"success"`
+	GroupJID             string `json:"group_jid"`
+	JoinApprovalRequired bool   `json:"join_approval_required"`
+}
+
+// CreateCommunityResponse represents the response for creating a community
+type CreateCommunityResponse struct {
+	Success              bool   `json:"success"`
+	CommunityJID         string `json:"community_jid"`
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	CreatedAt            int64  `json:"created_at"`
+	JoinApprovalRequired bool   `json:"join_approval_required"`
+	AnnouncementGroupJID string `json:"announcement_group_jid,omitempty"`
+}
+
+// CommunitySubGroup represents a group linked to a community
+type CommunitySubGroup struct {
+	GroupJID       string `json:"group_jid"`
+	Name           string `json:"name"`
+	IsAnnouncement bool   `json:"is_announcement"`
+}
+
+// CommunitySubGroupsResponse represents the response for listing the groups of a community
+type CommunitySubGroupsResponse struct {
+	Success              bool                `json:"success"`
+	CommunityJID         string              `json:"community_jid"`
+	AnnouncementGroupJID string              `json:"announcement_group_jid,omitempty"`
+	Count                int                 `json:"count"`
+	SubGroups            []CommunitySubGroup `json:"sub_groups"`
+}
+
+// CommunityGroupResult represents the outcome of linking or unlinking a single group
+type CommunityGroupResult struct {
+	GroupJID string `json:"group_jid"`
+	Action   string `json:"action"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// UpdateCommunityGroupsResponse represents the response for linking and unlinking community groups
+type UpdateCommunityGroupsResponse struct {
+	Success      bool                   `json:"success"`
+	CommunityJID string                 `json:"community_jid"`
+	Succeeded    int                    `json:"succeeded"`
+	Failed       int                    `json:"failed"`
+	Results      []CommunityGroupResult `json:"results"`
+}
+
+// CommunityAnnouncementResponse represents the response for posting to the announcement group of a community
+type CommunityAnnouncementResponse struct {
+	Success              bool   `json:"success"`
+	MessageID            string `json:"message_id"`
+	Timestamp            int64  `json:"timestamp"`
+	CommunityJID         string `json:"community_jid"`
+	AnnouncementGroupJID string `json:"announcement_group_jid"`
+	Text                 string `json:"text"`
+}
+
+// Contact represents a WhatsApp user with their known names
+type Contact struct {
+	JID          string `json:"jid"`
+	PhoneNumber  string `json:"phone_number,omitempty"`
+	Name         string `json:"name,omitempty"`
+	FullName     string `json:"full_name,omitempty"`
+	FirstName    string `json:"first_name,omitempty"`
+	PushName     string `json:"push_name,omitempty"`
+	BusinessName string `json:"business_name,omitempty"`
+}
+
+// ContactsResponse represents the response for listing contacts
+type ContactsResponse struct {
+	Contacts []Contact `json:"contacts"`
+	Success  bool      `json:"success"`
+	Query    string    `json:"query,omitempty"`
+	Count    int       `json:"count"`
+}
+
+// Chat types used to filter the chat list
+const (
+	ChatTypeDirect    = "direct"
+	ChatTypeGroup     = "group"
+	ChatTypeBroadcast = "broadcast"
+)
+
+// Chat represents a conversation in the chat list
+type Chat struct {
+	JID             string   `json:"jid"`
+	Type            string   `json:"type"`
+	Name            string   `json:"name,omitempty"`
+	LastMessageID   string   `json:"last_message_id,omitempty"`
+	LastMessageAt   int64    `json:"last_message_at,omitempty"`
+	LastMessageText string   `json:"last_message_text,omitempty"`
+	UnreadCount     int      `json:"unread_count"`
+	MarkedUnread    bool     `json:"marked_unread"`
+	IsArchived      bool     `json:"is_archived"`
+	IsPinned        bool     `json:"is_pinned"`
+	IsMuted         bool     `json:"is_muted"`
+	MutedUntil      int64    `json:"muted_until,omitempty"`
+	LabelIDs        []string `json:"label_ids,omitempty"`
+}
+
+// ChatsResponse represents the response for listing chats
+type ChatsResponse struct {
+	Chats   []Chat `json:"chats"`
+	Success bool   `json:"success"`
+	Count   int    `json:"count"`
+	Total   int    `json:"total"`
+	Offset  int    `json:"offset"`
+	HasMore bool   `json:"has_more"`
+}
+
+// ChatSettingsResponse represents the response for archiving, pinning, muting or marking a chat as unread
+type ChatSettingsResponse struct {
+	Success bool   `json:"success"`
+	JID     string `json:"jid"`
+	// Chat holds the updated chat, unless no message of the chat is stored yet
+	Chat *Chat `json:"chat,omitempty"`
+}
+
+// Label represents a label of a WhatsApp Business account, used to sort chats and messages
+type Label struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Index in the WhatsApp color palette
+	Color int `json:"color"`
+	// ID of the built-in label this label was created from, e.g. "New customer"
+	PredefinedID int `json:"predefined_id,omitempty"`
+	ChatCount    int `json:"chat_count"`
+	MessageCount int `json:"message_count"`
+}
+
+// LabelsResponse represents the response for listing labels
+type LabelsResponse struct {
+	Labels  []Label `json:"labels"`
+	Success bool    `json:"success"`
+	Count   int     `json:"count"`
+}
+
+// LabelResponse represents the response for creating, editing or deleting a label
+type LabelResponse struct {
+	Success bool  `json:"success"`
+	Label   Label `json:"label"`
+	Deleted bool  `json:"deleted,omitempty"`
+}
+
+// LabelAssociationResponse represents the response for adding a label to or removing it from a chat or message
+type LabelAssociationResponse struct {
+	Success   bool   `json:"success"`
+	LabelID   string `json:"label_id"`
+	Chat      string `json:"chat"`
+	MessageID string `json:"message_id,omitempty"`
+	Labeled   bool   `json:"labeled"`
+}
+
+// UserInfo represents what WhatsApp reports about a user
+type UserInfo struct {
+	JID          string   `json:"jid"`
+	PhoneNumber  string   `json:"phone_number,omitempty"`
+	LID          string   `json:"lid,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Status       string   `json:"status,omitempty"`
+	VerifiedName string   `json:"verified_name,omitempty"`
+	IsBusiness   bool     `json:"is_business"`
+	PictureID    string   `json:"picture_id,omitempty"`
+	Devices      []string `json:"devices"`
+	FetchedAt    int64    `json:"fetched_at"`
+	Cached       bool     `json:"cached"`
+}
+
+// UserInfoResponse represents the response for looking up users
+type UserInfoResponse struct {
+	Success bool                `json:"success"`
+	Users   map[string]UserInfo `json:"users"`
+	Count   int                 `json:"count"`
+}
+
+// ProfilePictureResponse represents the response for looking up the profile picture of a user or group
+type ProfilePictureResponse struct {
+	Success    bool   `json:"success"`
+	JID        string `json:"jid"`
+	HasPicture bool   `json:"has_picture"`
+	PictureID  string `json:"picture_id,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Type       string `json:"type,omitempty"`
+	FetchedAt  int64  `json:"fetched_at"`
+	Cached     bool   `json:"cached"`
+}
+
+// BusinessHours represents the opening hours of a business on one day of the week
+type BusinessHours struct {
+	DayOfWeek string `json:"day_of_week"`
+	Mode      string `json:"mode"`
+	OpenTime  string `json:"open_time,omitempty"`
+	CloseTime string `json:"close_time,omitempty"`
+}
+
+// BusinessCategory represents a category of a business
+type BusinessCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// BusinessProfileResponse represents the response for looking up a business profile
+type BusinessProfileResponse struct {
+	Success        bool               `json:"success"`
+	JID            string             `json:"jid"`
+	Description    string             `json:"description,omitempty"`
+	Address        string             `json:"address,omitempty"`
+	Email          string             `json:"email,omitempty"`
+	Websites       []string           `json:"websites"`
+	Categories     []BusinessCategory `json:"categories"`
+	HoursTimezone  string             `json:"hours_timezone,omitempty"`
+	Hours          []BusinessHours    `json:"hours"`
+	ProfileOptions map[string]string  `json:"profile_options,omitempty"`
+	FetchedAt      int64              `json:"fetched_at"`
+	Cached         bool               `json:"cached"`
+}
+
+// BusinessProduct represents a product of a business catalog
+type BusinessProduct struct {
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Description      string  `json:"description,omitempty"`
+	Price            float64 `json:"price,omitempty"`
+	Currency         string  `json:"currency,omitempty"`
+	RetailerID       string  `json:"retailer_id,omitempty"`
+	URL              string  `json:"url,omitempty"`
+	ImageURL         string  `json:"image_url,omitempty"`
+	OriginalImageURL string  `json:"original_image_url,omitempty"`
+	ReviewStatus     string  `json:"review_status,omitempty"`
+	IsHidden         bool    `json:"is_hidden"`
+}
+
+// BusinessCatalogResponse represents the response for listing the products of a business catalog
+type BusinessCatalogResponse struct {
+	Success    bool              `json:"success"`
+	JID        string            `json:"jid"`
+	Products   []BusinessProduct `json:"products"`
+	Count      int               `json:"count"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
+
+// BusinessCollection represents a collection of products in a business catalog
+type BusinessCollection struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	ReviewStatus string            `json:"review_status,omitempty"`
+	Products     []BusinessProduct `json:"products"`
+}
+
+// BusinessCollectionsResponse represents the response for listing the collections of a business catalog
+type BusinessCollectionsResponse struct {
+	Success     bool                 `json:"success"`
+	JID         string               `json:"jid"`
+	Collections []BusinessCollection `json:"collections"`
+	Count       int                  `json:"count"`
+}
+
+// Chat states shown while a user is writing in a chat
+const (
+	ChatStateComposing = "composing"
+	ChatStateRecording = "recording"
+	ChatStatePaused    = "paused"
+)
+
+// UserPresence represents the last known online status of a user
+type UserPresence struct {
+	JID       string `json:"jid"`
+	Available bool   `json:"available"`
+	LastSeen  int64  `json:"last_seen,omitempty"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// ChatState represents whether a user is typing or recording in a chat
+type ChatState struct {
+	Chat      string `json:"chat"`
+	From      string `json:"from"`
+	State     string `json:"state"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// PresenceResponse represents the response for setting our global presence
+type PresenceResponse struct {
+	Success  bool   `json:"success"`
+	Presence string `json:"presence"`
+}
+
+// ChatPresenceResponse represents the response for sending a chat state to a chat
+type ChatPresenceResponse struct {
+	Success bool   `json:"success"`
+	JID     string `json:"jid"`
+	State   string `json:"state"`
+}
+
+// PresenceSubscriptionResponse represents the response for subscribing to the presence of a user
+// Presence and ChatState hold what is already known about the user, if anything
+type PresenceSubscriptionResponse struct {
+	Success   bool          `json:"success"`
+	JID       string        `json:"jid"`
+	Presence  *UserPresence `json:"presence,omitempty"`
+	ChatState *ChatState    `json:"chat_state,omitempty"`
 }
 
 // WhatsAppCheckResult represents a single phone number check result
@@ -55,15 +588,124 @@ type WhatsAppCheckResponse struct {
 
 // Message represents a single chat message
 type Message struct {
-	ID              string `json:"id"`
-	From            string `json:"from"`
-	To              string `json:"to,omitempty"`
-	Text            string `json:"text"`
-	Timestamp       int64  `json:"timestamp"`
-	Chat            string `json:"chat"`
-	QuotedMessageID string `json:"quoted_message_id,omitempty"`
+	ID              string            `json:"id"`
+	From            string            `json:"from"`
+	FromName        string            `json:"from_name,omitempty"`
+	To              string            `json:"to,omitempty"`
+	Text            string            `json:"text"`
+	Timestamp       int64             `json:"timestamp"`
+	Chat            string            `json:"chat"`
+	QuotedMessageID string            `json:"quoted_message_id,omitempty"`
+	MessageType     string            `json:"message_type,omitempty"`
+	Media           *MediaInfo        `json:"media,omitempty"`
+	Location        *LocationInfo     `json:"location,omitempty"`
+	Contacts        []ContactCard     `json:"contacts,omitempty"`
+	GroupInvite     *GroupInvite      `json:"group_invite,omitempty"`
+	GroupEvent      *GroupEvent       `json:"group_event,omitempty"`
+	Reactions       []ReactionSummary `json:"reactions,omitempty"`
+	LabelIDs        []string          `json:"label_ids,omitempty"`
+	EditedAt        int64             `json:"edited_at,omitempty"`
+	DeletedAt       int64             `json:"deleted_at,omitempty"`
+	DeletedBy       string            `json:"deleted_by,omitempty"`
+}
+
+// MediaInfo represents metadata of media attached to a message
+type MediaInfo struct {
+	MimeType        string `json:"mimetype,omitempty"`
+	FileName        string `json:"filename,omitempty"`
+	FileLength      uint64 `json:"file_length,omitempty"`
+	PageCount       int    `json:"page_count,omitempty"`
+	DurationSeconds uint32 `json:"duration_seconds,omitempty"`
 }
 
+// LocationInfo represents the coordinates of a location or live location message
+type LocationInfo struct {
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	Name           string  `json:"name,omitempty"`
+	Address        string  `json:"address,omitempty"`
+	AccuracyMeters uint32  `json:"accuracy_meters,omitempty"`
+}
+
+// ReactionSummary represents the reactions to a message with the same emoji
+type ReactionSummary struct {
+	Emoji   string   `json:"emoji"`
+	Count   int      `json:"count"`
+	Senders []string `json:"senders"`
+}
+
+// ContactCard represents a contact shared in a contact message
+type ContactCard struct {
+	Name         string         `json:"name"`
+	Organization string         `json:"organization,omitempty"`
+	Phones       []ContactPhone `json:"phones,omitempty"`
+	Emails       []string       `json:"emails,omitempty"`
+	VCard        string         `json:"vcard,omitempty"`
+}
+
+// GroupInvite represents the invitation carried by a group invite message
+type GroupInvite struct {
+	GroupJID   string `json:"group_jid"`
+	GroupName  string `json:"group_name,omitempty"`
+	Code       string `json:"code"`
+	Expiration int64  `json:"expiration,omitempty"`
+	Inviter    string `json:"inviter,omitempty"`
+}
+
+// GroupEvent represents a change of a group shown as a system message, such as participants joining or a new name
+type GroupEvent struct {
+	Action       string   `json:"action"`
+	Actor        string   `json:"actor,omitempty"`
+	Participants []string `json:"participants,omitempty"`
+	Value        string   `json:"value,omitempty"`
+}
+
+// ContactPhone represents a phone number of a shared contact
+type ContactPhone struct {
+	Number string `json:"number"`
+	Type   string `json:"type,omitempty"`
+	JID    string `json:"jid,omitempty"`
+}
+
+// Message types stored in the message_type column
+const (
+	MessageTypeText         = "text"
+	MessageTypeImage        = "image"
+	MessageTypeSticker      = "sticker"
+	MessageTypeDocument     = "document"
+	MessageTypeAudio        = "audio"
+	MessageTypeVoice        = "voice"
+	MessageTypeVideo        = "video"
+	MessageTypeGIF          = "gif"
+	MessageTypeLocation     = "location"
+	MessageTypeLiveLocation = "live_location"
+	MessageTypeContact      = "contact"
+	MessageTypePoll         = "poll"
+	MessageTypeGroupInvite  = "group_invite"
+	MessageTypeGroupEvent   = "group_event"
+)
+
+// Group event actions stored in the action field of group events
+const (
+	GroupEventCreate          = "create"
+	GroupEventAdd             = "add"
+	GroupEventJoin            = "join"
+	GroupEventRemove          = "remove"
+	GroupEventLeave           = "leave"
+	GroupEventPromote         = "promote"
+	GroupEventDemote          = "demote"
+	GroupEventName            = "name"
+	GroupEventDescription     = "description"
+	GroupEventLocked          = "locked"
+	GroupEventAnnounce        = "announce"
+	GroupEventEphemeral       = "ephemeral"
+	GroupEventJoinApproval    = "join_approval"
+	GroupEventInviteLinkReset = "invite_link_reset"
+	GroupEventLinkGroup       = "link_group"
+	GroupEventUnlinkGroup     = "unlink_group"
+	GroupEventDelete          = "delete"
+)
+
 // ChatHistoryResponse represents the response for chat history retrieval
 type ChatHistoryResponse struct {
 	Messages []Message `json:"messages"`
@@ -0,0 +1,208 @@
+package vcard
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Card holds the fields of a vCard used for WhatsApp contact messages
+type Card struct {
+	Name         string  // Formatted name (FN)
+	Organization string  // Organization (ORG)
+	Phones       []Phone // Phone numbers (TEL)
+	Emails       []string
+}
+
+// Phone is a phone number of a vCard
+type Phone struct {
+	Number string // Number as written in the card
+	Type   string // Type such as CELL, WORK or HOME
+	WaID   string // WhatsApp ID (digits only) if the number is on WhatsApp
+}
+
+// Build creates a vCard 3.0 string for a contact with the given name, phone numbers and emails.
+// Phone numbers are tagged with their WhatsApp ID so recipients can open a chat directly,
+// so they must be in international format with a leading + or 00 and the country code.
+func Build(name, organization string, phones, emails []string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("contact name is required")
+	}
+	if len(phones) == 0 && len(emails) == 0 {
+		return "", fmt.Errorf("contact %q needs at least one phone number or email", name)
+	}
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VCARD\n")
+	b.WriteString("VERSION:3.0\n")
+	b.WriteString("N:" + structuredName(name) + "\n")
+	b.WriteString("FN:" + escape(name) + "\n")
+	if organization != "" {
+		b.WriteString("ORG:" + escape(organization) + "\n")
+	}
+	for _, phone := range phones {
+		digits := internationalDigits(phone)
+		if digits == "" {
+			return "", fmt.Errorf("invalid phone number %q, use international format with country code like +1234567890", phone)
+		}
+		b.WriteString(fmt.Sprintf("TEL;type=CELL;type=VOICE;waid=%s:+%s\n", digits, digits))
+	}
+	for _, email := range emails {
+		if !strings.Contains(email, "@") {
+			return "", fmt.Errorf("invalid email address %q", email)
+		}
+		b.WriteString("EMAIL;type=INTERNET:" + escape(strings.TrimSpace(email)) + "\n")
+	}
+	b.WriteString("END:VCARD")
+
+	return b.String(), nil
+}
+
+// Parse reads the fields of a vCard (versions 2.1, 3.0 and 4.0)
+func Parse(raw string) (*Card, error) {
+	lines := unfold(raw)
+	if len(lines) == 0 || !strings.EqualFold(strings.TrimSpace(lines[0]), "BEGIN:VCARD") {
+		return nil, fmt.Errorf("vCard must start with BEGIN:VCARD")
+	}
+
+	card := &Card{}
+	var structured string
+	ended := false
+	for _, line := range lines[1:] {
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "END":
+			ended = true
+		case "FN":
+			card.Name = unescape(value)
+		case "N":
+			structured = value
+		case "ORG":
+			// Organization units are separated by semicolons, keep the organization name only
+			card.Organization = unescape(strings.SplitN(value, ";", 2)[0])
+		case "TEL":
+			phone := Phone{Number: unescape(value)}
+			for _, param := range params {
+				key, val, hasValue := strings.Cut(param, "=")
+				switch {
+				case strings.EqualFold(key, "waid"):
+					phone.WaID = val
+				case strings.EqualFold(key, "type") && phone.Type == "":
+					phone.Type = strings.ToUpper(val)
+				case !hasValue && phone.Type == "":
+					// vCard 2.1 style bare type parameter
+					phone.Type = strings.ToUpper(key)
+				}
+			}
+			card.Phones = append(card.Phones, phone)
+		case "EMAIL":
+			card.Emails = append(card.Emails, unescape(value))
+		}
+	}
+
+	if !ended {
+		return nil, fmt.Errorf("vCard must end with END:VCARD")
+	}
+
+	// Fall back to the structured name (family;given;additional;prefix;suffix)
+	if card.Name == "" && structured != "" {
+		parts := strings.Split(structured, ";")
+		var names []string
+		for _, i := range []int{3, 1, 2, 0, 4} {
+			if i < len(parts) && parts[i] != "" {
+				names = append(names, unescape(parts[i]))
+			}
+		}
+		card.Name = strings.Join(names, " ")
+	}
+
+	return card, nil
+}
+
+// unfold splits a vCard into logical lines, joining continuation lines that start with whitespace
+func unfold(raw string) []string {
+	raw = strings.ReplaceAll(raw, "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(raw, "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// splitProperty splits a content line into its upper-cased name, parameters and value
+func splitProperty(line string) (string, []string, string, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(head, ";")
+	name := strings.ToUpper(parts[0])
+	// Drop group prefixes such as "item1.TEL"
+	if idx := strings.LastIndex(name, "."); idx != -1 {
+		name = name[idx+1:]
+	}
+
+	return name, parts[1:], strings.TrimSpace(value), true
+}
+
+// structuredName builds the N property value (family;given;;;) from a full name
+func structuredName(name string) string {
+	given, family := name, ""
+	if idx := strings.LastIndex(name, " "); idx != -1 {
+		given, family = name[:idx], name[idx+1:]
+	}
+	return escape(family) + ";" + escape(given) + ";;;"
+}
+
+// internationalDigits returns the digits of a phone number in international format, including the country code
+// Numbers without a leading + or 00 are local numbers whose country code is unknown, they return an empty string
+func internationalDigits(phone string) string {
+	phone = strings.TrimSpace(phone)
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		phone = phone[1:]
+	case strings.HasPrefix(phone, "00"):
+		phone = phone[2:]
+	default:
+		return ""
+	}
+
+	// E.164 numbers have at most 15 digits and country codes never start with 0
+	digits := phoneDigits(phone)
+	if len(digits) < 7 || len(digits) > 15 || digits[0] == '0' {
+		return ""
+	}
+	return digits
+}
+
+// phoneDigits strips everything but digits from a phone number
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// escape escapes special characters in a vCard text value
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\n", `\n`).Replace(value)
+}
+
+// unescape reverses escape
+func unescape(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\,`, ",", `\;`, ";", `\n`, "\n", `\N`, "\n").Replace(value)
+}
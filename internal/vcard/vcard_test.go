@@ -0,0 +1,29 @@
+package vcard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildRequiresInternationalPhoneNumbers(t *testing.T) {
+	for _, phone := range []string{"0612 345 678", "612345678", "+", "+0612345678"} {
+		if _, err := Build("Jane Doe", "", []string{phone}, nil); err == nil {
+			t.Errorf("expected an error for phone number %q", phone)
+		}
+	}
+
+	for phone, waid := range map[string]string{
+		"+31 6 12345678":   "31612345678",
+		"0031 6 12345678":  "31612345678",
+		"+1 (234) 567-890": "1234567890",
+	} {
+		card, err := Build("Jane Doe", "", []string{phone}, nil)
+		if err != nil {
+			t.Errorf("unexpected error for phone number %q: %v", phone, err)
+			continue
+		}
+		if !strings.Contains(card, "waid="+waid+":+"+waid+"\n") {
+			t.Errorf("expected waid %s for phone number %q, got:\n%s", waid, phone, card)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+-- Remove media metadata fields from messages table
+DROP INDEX IF EXISTS messages_message_type_idx;
+ALTER TABLE messages DROP COLUMN IF EXISTS media_page_count;
+ALTER TABLE messages DROP COLUMN IF EXISTS media_file_length;
+ALTER TABLE messages DROP COLUMN IF EXISTS media_filename;
+ALTER TABLE messages DROP COLUMN IF EXISTS media_mimetype;
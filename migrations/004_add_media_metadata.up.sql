@@ -0,0 +1,14 @@
+-- Add media metadata fields to messages table
+ALTER TABLE messages ADD COLUMN media_mimetype TEXT;
+ALTER TABLE messages ADD COLUMN media_filename TEXT;
+ALTER TABLE messages ADD COLUMN media_file_length BIGINT;
+ALTER TABLE messages ADD COLUMN media_page_count INTEGER;
+
+-- Create index for filtering messages by type
+CREATE INDEX messages_message_type_idx ON messages(chat_jid, message_type);
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.media_mimetype IS 'MIME type of the attached media (image, document, audio, video)';
+COMMENT ON COLUMN messages.media_filename IS 'Original filename of a document attachment';
+COMMENT ON COLUMN messages.media_file_length IS 'Size of the attached media in bytes';
+COMMENT ON COLUMN messages.media_page_count IS 'Number of pages of a document attachment, if known';
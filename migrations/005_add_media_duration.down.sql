@@ -0,0 +1,2 @@
+-- Remove media duration field from messages table
+ALTER TABLE messages DROP COLUMN IF EXISTS media_duration_seconds;
@@ -0,0 +1,5 @@
+-- Add media duration field to messages table
+ALTER TABLE messages ADD COLUMN media_duration_seconds INTEGER;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.media_duration_seconds IS 'Duration of an audio or video attachment in seconds';
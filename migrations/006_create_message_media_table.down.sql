@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS message_media_our_jid_idx;
+
+-- Drop message_media table
+DROP TABLE IF EXISTS message_media CASCADE;
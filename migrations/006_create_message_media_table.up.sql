@@ -0,0 +1,23 @@
+-- Create message_media table for storing download metadata of media messages
+CREATE TABLE message_media (
+    message_id TEXT PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    our_jid TEXT NOT NULL,
+    media_type TEXT NOT NULL,
+    direct_path TEXT NOT NULL,
+    media_key BYTEA NOT NULL,
+    file_sha256 BYTEA,
+    file_enc_sha256 BYTEA,
+    mimetype TEXT,
+    file_length BIGINT,
+    local_path TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for efficient querying
+CREATE INDEX message_media_our_jid_idx ON message_media(our_jid);
+
+-- Add comments for clarity
+COMMENT ON COLUMN message_media.media_type IS 'Message type of the media message (image, sticker, video, gif, audio, voice, document)';
+COMMENT ON COLUMN message_media.direct_path IS 'WhatsApp CDN path used to download the encrypted media';
+COMMENT ON COLUMN message_media.local_path IS 'Path of the decrypted file cached in the static directory, if downloaded';
@@ -0,0 +1,6 @@
+-- Remove location fields from messages table
+ALTER TABLE messages DROP COLUMN IF EXISTS location_accuracy_meters;
+ALTER TABLE messages DROP COLUMN IF EXISTS location_address;
+ALTER TABLE messages DROP COLUMN IF EXISTS location_name;
+ALTER TABLE messages DROP COLUMN IF EXISTS location_longitude;
+ALTER TABLE messages DROP COLUMN IF EXISTS location_latitude;
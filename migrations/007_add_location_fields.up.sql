@@ -0,0 +1,13 @@
+-- Add location fields to messages table
+ALTER TABLE messages ADD COLUMN location_latitude DOUBLE PRECISION;
+ALTER TABLE messages ADD COLUMN location_longitude DOUBLE PRECISION;
+ALTER TABLE messages ADD COLUMN location_name TEXT;
+ALTER TABLE messages ADD COLUMN location_address TEXT;
+ALTER TABLE messages ADD COLUMN location_accuracy_meters INTEGER;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.location_latitude IS 'Latitude of a location or live location message in degrees';
+COMMENT ON COLUMN messages.location_longitude IS 'Longitude of a location or live location message in degrees';
+COMMENT ON COLUMN messages.location_name IS 'Name of the shared place, if any';
+COMMENT ON COLUMN messages.location_address IS 'Address of the shared place, if any';
+COMMENT ON COLUMN messages.location_accuracy_meters IS 'Accuracy of a live location in meters, if known';
@@ -0,0 +1,2 @@
+-- Remove shared contact cards field from messages table
+ALTER TABLE messages DROP COLUMN IF EXISTS contacts;
@@ -0,0 +1,5 @@
+-- Add shared contact cards field to messages table
+ALTER TABLE messages ADD COLUMN contacts JSONB;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.contacts IS 'Parsed vCards of a contact message, as a JSON array';
@@ -0,0 +1,2 @@
+-- Drop message_reactions table
+DROP TABLE IF EXISTS message_reactions;
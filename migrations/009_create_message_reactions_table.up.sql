@@ -0,0 +1,21 @@
+-- Create message_reactions table for storing emoji reactions to messages
+CREATE TABLE message_reactions (
+    our_jid TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    sender_jid TEXT NOT NULL,
+    chat_jid TEXT NOT NULL,
+    emoji TEXT NOT NULL,
+    timestamp BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, message_id, sender_jid)
+);
+
+-- Create indexes for efficient querying
+CREATE INDEX message_reactions_chat_jid_idx ON message_reactions(our_jid, chat_jid);
+
+-- Add comments for clarity
+COMMENT ON TABLE message_reactions IS 'Latest reaction of each sender to a message, removed reactions are kept with an empty emoji so older reactions delivered late cannot bring them back';
+COMMENT ON COLUMN message_reactions.message_id IS 'ID of the message that was reacted to';
+COMMENT ON COLUMN message_reactions.sender_jid IS 'JID of the reacting user, or self for our own reactions';
+COMMENT ON COLUMN message_reactions.emoji IS 'Reaction emoji, empty for a removed reaction (tombstone), which is not listed with messages';
@@ -0,0 +1,3 @@
+-- Drop message_revisions table and edit tracking
+DROP TABLE IF EXISTS message_revisions;
+ALTER TABLE messages DROP COLUMN IF EXISTS edited_at;
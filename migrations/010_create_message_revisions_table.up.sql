@@ -0,0 +1,21 @@
+-- Track when a message was last edited
+ALTER TABLE messages ADD COLUMN edited_at BIGINT;
+
+-- Create message_revisions table for keeping previous versions of edited messages
+CREATE TABLE message_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    our_jid TEXT NOT NULL,
+    message_id TEXT NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    message_text TEXT NOT NULL,
+    replaced_at BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for efficient querying
+CREATE INDEX message_revisions_message_idx ON message_revisions(our_jid, message_id, replaced_at);
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.edited_at IS 'Unix timestamp of the last edit, NULL if the message was never edited';
+COMMENT ON TABLE message_revisions IS 'Previous versions of message text, one row per replaced version';
+COMMENT ON COLUMN message_revisions.message_text IS 'Text of the message before it was replaced';
+COMMENT ON COLUMN message_revisions.replaced_at IS 'Unix timestamp when this version was replaced';
@@ -0,0 +1,3 @@
+-- Remove revoke tracking fields from messages table
+ALTER TABLE messages DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE messages DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+-- Add revoke tracking fields to messages table
+ALTER TABLE messages ADD COLUMN deleted_at BIGINT;
+ALTER TABLE messages ADD COLUMN deleted_by TEXT;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.deleted_at IS 'Unix timestamp when the message was deleted for everyone, NULL if not deleted';
+COMMENT ON COLUMN messages.deleted_by IS 'JID of the user who deleted the message (sender or group admin), or self';
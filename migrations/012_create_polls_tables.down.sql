@@ -0,0 +1,3 @@
+-- Drop poll tables
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS polls;
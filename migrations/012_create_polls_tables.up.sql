@@ -0,0 +1,30 @@
+-- Create polls table for storing poll definitions
+CREATE TABLE polls (
+    message_id TEXT PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    our_jid TEXT NOT NULL,
+    chat_jid TEXT NOT NULL,
+    question TEXT NOT NULL,
+    options TEXT[] NOT NULL,
+    selectable_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create poll_votes table for storing the latest vote of each voter
+CREATE TABLE poll_votes (
+    our_jid TEXT NOT NULL,
+    poll_message_id TEXT NOT NULL REFERENCES polls(message_id) ON DELETE CASCADE,
+    voter_jid TEXT NOT NULL,
+    selected_options TEXT[] NOT NULL,
+    timestamp BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, poll_message_id, voter_jid)
+);
+
+-- Create indexes for efficient querying
+CREATE INDEX polls_our_jid_idx ON polls(our_jid);
+
+-- Add comments for clarity
+COMMENT ON COLUMN polls.selectable_count IS 'Maximum number of options a voter may select, 0 means any number';
+COMMENT ON COLUMN poll_votes.selected_options IS 'Names of the selected options, empty if the vote was retracted';
+COMMENT ON COLUMN poll_votes.voter_jid IS 'JID of the voter, or self for our own votes';
@@ -0,0 +1,2 @@
+-- Remove group invite field from messages table
+ALTER TABLE messages DROP COLUMN IF EXISTS group_invite;
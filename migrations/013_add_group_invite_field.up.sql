@@ -0,0 +1,5 @@
+-- Add group invite field to messages table
+ALTER TABLE messages ADD COLUMN group_invite JSONB;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.group_invite IS 'Group, invite code and expiration of a group invite message, as a JSON object';
@@ -0,0 +1,5 @@
+-- Add group event field to messages table
+ALTER TABLE messages ADD COLUMN group_event JSONB;
+
+-- Add comments for clarity
+COMMENT ON COLUMN messages.group_event IS 'Action, actor, participants and new value of a group event system message, as a JSON object';
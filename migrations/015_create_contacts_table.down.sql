@@ -0,0 +1,2 @@
+-- Drop contacts table
+DROP TABLE IF EXISTS contacts;
@@ -0,0 +1,19 @@
+-- Create contacts table for resolving JIDs to names
+CREATE TABLE contacts (
+    our_jid TEXT NOT NULL,
+    jid TEXT NOT NULL,
+    first_name TEXT NOT NULL DEFAULT '',
+    full_name TEXT NOT NULL DEFAULT '',
+    push_name TEXT NOT NULL DEFAULT '',
+    business_name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, jid)
+);
+
+-- Add comments for clarity
+COMMENT ON TABLE contacts IS 'Names of WhatsApp users from the address book, push names and verified business names';
+COMMENT ON COLUMN contacts.jid IS 'JID of the user without device, phone number or LID based';
+COMMENT ON COLUMN contacts.full_name IS 'Name saved in the address book of our phone';
+COMMENT ON COLUMN contacts.push_name IS 'Name the user set for themselves in WhatsApp';
+COMMENT ON COLUMN contacts.business_name IS 'Verified name of a business account';
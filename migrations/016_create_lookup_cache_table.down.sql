@@ -0,0 +1,2 @@
+-- Drop lookup_cache table
+DROP TABLE IF EXISTS lookup_cache;
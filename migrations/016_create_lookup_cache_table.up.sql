@@ -0,0 +1,14 @@
+-- Create lookup_cache table for caching user info and profile picture lookups
+CREATE TABLE lookup_cache (
+    our_jid TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    jid TEXT NOT NULL,
+    data JSONB NOT NULL,
+    fetched_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (our_jid, kind, jid)
+);
+
+-- Add comments for clarity
+COMMENT ON TABLE lookup_cache IS 'Results of lookups on WhatsApp servers, reused until they are older than the cache TTL';
+COMMENT ON COLUMN lookup_cache.kind IS 'Kind of lookup, e.g. user_info or profile_picture';
+COMMENT ON COLUMN lookup_cache.data IS 'Lookup result as a JSON object';
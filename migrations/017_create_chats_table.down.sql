@@ -0,0 +1,2 @@
+-- Drop chats table
+DROP TABLE IF EXISTS chats;
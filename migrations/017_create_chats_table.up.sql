@@ -0,0 +1,45 @@
+-- Create chats table for listing conversations
+CREATE TABLE chats (
+    our_jid TEXT NOT NULL,
+    jid TEXT NOT NULL,
+    chat_type TEXT NOT NULL DEFAULT 'direct',
+    name TEXT NOT NULL DEFAULT '',
+    last_message_id TEXT,
+    last_message_at BIGINT NOT NULL DEFAULT 0,
+    unread_count INTEGER NOT NULL DEFAULT 0,
+    is_archived BOOLEAN NOT NULL DEFAULT false,
+    is_pinned BOOLEAN NOT NULL DEFAULT false,
+    muted_until BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, jid)
+);
+
+-- Create index for listing the most recent chats first
+CREATE INDEX chats_last_message_idx ON chats(our_jid, last_message_at DESC);
+
+-- Fill the table from the messages stored so far
+INSERT INTO chats (our_jid, jid, chat_type, last_message_id, last_message_at, unread_count)
+SELECT DISTINCT ON (our_jid, chat_jid)
+    our_jid,
+    chat_jid,
+    CASE
+        WHEN chat_jid LIKE '%@g.us' THEN 'group'
+        WHEN chat_jid LIKE '%@broadcast' OR chat_jid LIKE '%@newsletter' THEN 'broadcast'
+        ELSE 'direct'
+    END,
+    id,
+    timestamp,
+    (SELECT COUNT(*) FROM messages unread
+     WHERE unread.our_jid = messages.our_jid AND unread.chat_jid = messages.chat_jid AND unread.is_read = false
+       AND (unread.message_type != 'text' OR TRIM(unread.message_text) != ''))
+FROM messages
+WHERE message_type != 'text' OR TRIM(message_text) != ''
+ORDER BY our_jid, chat_jid, timestamp DESC, id DESC;
+
+-- Add comments for clarity
+COMMENT ON TABLE chats IS 'Conversations with their last message, unread count and chat settings';
+COMMENT ON COLUMN chats.chat_type IS 'direct, group or broadcast';
+COMMENT ON COLUMN chats.name IS 'Name of the chat from WhatsApp, e.g. the group subject; direct chats are named from contacts';
+COMMENT ON COLUMN chats.unread_count IS 'Number of unread messages, kept in sync with messages.is_read';
+COMMENT ON COLUMN chats.muted_until IS 'Unix time until which the chat is muted, -1 for forever, 0 if not muted';
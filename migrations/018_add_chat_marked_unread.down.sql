@@ -0,0 +1,2 @@
+-- Remove marked unread flag from chats table
+ALTER TABLE chats DROP COLUMN IF EXISTS marked_unread;
@@ -0,0 +1,5 @@
+-- Add marked unread flag to chats table
+ALTER TABLE chats ADD COLUMN marked_unread BOOLEAN NOT NULL DEFAULT false;
+
+-- Add comments for clarity
+COMMENT ON COLUMN chats.marked_unread IS 'Whether the chat was marked as unread on the phone or with mark_chat_unread, cleared when the chat is read';
@@ -0,0 +1,3 @@
+-- Drop labels tables
+DROP TABLE IF EXISTS label_associations;
+DROP TABLE IF EXISTS labels;
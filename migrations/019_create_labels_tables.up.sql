@@ -0,0 +1,32 @@
+-- Create labels table for the chat labels of business accounts
+CREATE TABLE labels (
+    our_jid TEXT NOT NULL,
+    id TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    color INTEGER NOT NULL DEFAULT 0,
+    predefined_id INTEGER NOT NULL DEFAULT 0,
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, id)
+);
+
+-- Create label_associations table for the labels of chats and messages
+CREATE TABLE label_associations (
+    our_jid TEXT NOT NULL,
+    label_id TEXT NOT NULL,
+    chat_jid TEXT NOT NULL,
+    message_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (our_jid, label_id, chat_jid, message_id)
+);
+
+-- Create index for loading the labels of chats and messages
+CREATE INDEX label_associations_chat_jid_idx ON label_associations(our_jid, chat_jid);
+
+-- Add comments for clarity
+COMMENT ON TABLE labels IS 'Labels synced from WhatsApp app state, deleted labels are kept with deleted set';
+COMMENT ON COLUMN labels.color IS 'Index of the label color in the WhatsApp color palette';
+COMMENT ON COLUMN labels.predefined_id IS 'ID of the built-in label this label was created from, 0 for custom labels';
+COMMENT ON COLUMN labels.deleted IS 'Whether the label was deleted, its ID stays reserved since WhatsApp clients still associate it with the deleted label';
+COMMENT ON COLUMN label_associations.message_id IS 'Labeled message, empty when the whole chat is labeled';
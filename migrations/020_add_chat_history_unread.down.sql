@@ -0,0 +1,3 @@
+-- Remove history unread count from chats table
+ALTER TABLE chats DROP COLUMN IF EXISTS history_synced_at;
+ALTER TABLE chats DROP COLUMN IF EXISTS history_unread_count;
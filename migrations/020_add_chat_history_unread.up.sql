@@ -0,0 +1,8 @@
+-- Add the unread count reported by the phone in history sync to chats table
+ALTER TABLE chats ADD COLUMN history_unread_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE chats ADD COLUMN history_synced_at BIGINT NOT NULL DEFAULT 0;
+
+-- Add comments for clarity
+COMMENT ON COLUMN chats.history_unread_count IS 'Unread count the phone reported in history sync, cleared when the chat is read';
+COMMENT ON COLUMN chats.history_synced_at IS 'Unix time of the last message covered by history_unread_count';
+COMMENT ON COLUMN chats.unread_count IS 'history_unread_count plus the unread messages received after history_synced_at';
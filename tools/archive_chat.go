@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ArchiveChatTool creates and returns the archive_chat MCP tool
+func ArchiveChatTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("archive_chat",
+		mcp.WithDescription("Archive or unarchive a WhatsApp chat. The change is synced to the phone and all linked devices. Archiving a chat also unpins it. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithBoolean("archived",
+			mcp.Required(),
+			mcp.Description("true to archive the chat, false to move it back to the chat list"),
+		),
+	)
+
+	return tool
+}
+
+// HandleArchiveChat handles the archive_chat tool execution
+func HandleArchiveChat(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.ArchiveChatParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" || params.Archived == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'jid' and 'archived' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'jid' and 'archived'"), nil
+		}
+
+		// Update archive status using client interface
+		response, err := whatsappClient.ArchiveChat(ctx, params.JID, *params.Archived)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update archive status",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update archive status"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Unarchived chat %s.", response.JID)
+		if *params.Archived {
+			fallbackText = fmt.Sprintf("Archived chat %s.", response.JID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CreateCommunityTool creates and returns the create_community MCP tool
+func CreateCommunityTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("create_community",
+		mcp.WithDescription("Create a new WhatsApp community. WhatsApp creates an announcement group for the community automatically; use update_community_groups to link existing groups and send_community_announcement to post to all members. Requires authentication. Your session is automatically subscribed to notifications from the announcement group."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Community name shown to all members"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Optional community description"),
+		),
+		mcp.WithBoolean("join_approval",
+			mcp.Description("Require admin approval for new members to join the community"),
+		),
+	)
+
+	return tool
+}
+
+// HandleCreateCommunity handles the create_community tool execution
+func HandleCreateCommunity(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.CreateCommunityParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Name == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'name' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'name'"), nil
+		}
+
+		// Create community using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.CreateCommunity(ctx, params.Name, params.Description, params.JoinApproval)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "CREATE_FAILED",
+					Message: "Failed to create community",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to create community"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Community '%s' created with JID %s.", response.Name, response.CommunityJID)
+		if response.AnnouncementGroupJID != "" {
+			fallbackText += fmt.Sprintf(" Announcement group: %s. You are now subscribed to notifications from it.", response.AnnouncementGroupJID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
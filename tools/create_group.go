@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CreateGroupTool creates and returns the create_group MCP tool
+func CreateGroupTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("create_group",
+		mcp.WithDescription("Create a new WhatsApp group with the given participants and an optional description. The result reports for every participant whether they were added, and why not otherwise (e.g. privacy settings, not on WhatsApp). Requires authentication. Your session is automatically subscribed to notifications from the new group."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Group name (subject) shown to all participants"),
+		),
+		mcp.WithArray("participants",
+			mcp.Required(),
+			mcp.Description("JIDs of the participants to add (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("description",
+			mcp.Description("Optional group description"),
+		),
+	)
+
+	return tool
+}
+
+// HandleCreateGroup handles the create_group tool execution
+func HandleCreateGroup(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.CreateGroupParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if strings.TrimSpace(params.Name) == "" || len(params.Participants) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'name' and 'participants' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'name' and 'participants'"), nil
+		}
+
+		// Create group using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.CreateGroup(ctx, params.Name, params.Participants, params.Description)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "CREATE_FAILED",
+					Message: "Failed to create group",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to create group"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		added := 0
+		for _, participant := range response.Participants {
+			if participant.Success {
+				added++
+			}
+		}
+		fallbackText := fmt.Sprintf("Group '%s' created (%s) with %d of %d participant(s) added. You are now subscribed to notifications from this group.", response.Name, response.GroupJID, added, len(response.Participants))
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
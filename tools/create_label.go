@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CreateLabelTool creates and returns the create_label MCP tool
+func CreateLabelTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("create_label",
+		mcp.WithDescription("Create a label for sorting chats and messages, e.g. 'New lead' or 'Paid'. Labels are a WhatsApp Business feature and are synced to the phone and all linked devices. Returns the ID of the new label for label_chat and label_message. Requires authentication."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the label"),
+		),
+		mcp.WithNumber("color",
+			mcp.Description("Color of the label as an index in the WhatsApp color palette, 0 to 19 (default: the next color)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleCreateLabel handles the create_label tool execution
+func HandleCreateLabel(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.CreateLabelParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Name == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'name' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'name'"), nil
+		}
+
+		// Validate label color
+		if params.Color != nil && (*params.Color < 0 || *params.Color >= client.LabelColors) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'color' must be between 0 and 19",
+					Details: fmt.Sprintf("color=%d", *params.Color),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid label color"), nil
+		}
+
+		// Create label using client interface
+		response, err := whatsappClient.CreateLabel(ctx, params.Name, params.Color)
+		if errors.Is(err, client.ErrLabelsNotSynced) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABELS_NOT_SYNCED",
+					Message: "Labels could not be synced from the phone yet, try again later",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Labels not synced"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "CREATE_FAILED",
+					Message: "Failed to create label",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to create label"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Created label %q with ID %s.", response.Label.Name, response.Label.ID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CreatePollTool creates and returns the create_poll MCP tool
+func CreatePollTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("create_poll",
+		mcp.WithDescription("Create a poll in a WhatsApp chat or group. Votes on the poll are decrypted as they arrive and can be read with get_poll_results; subscribed sessions are notified when a vote changes. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithString("question",
+			mcp.Required(),
+			mcp.Description("The poll question (e.g., 'Where should we meet?')"),
+		),
+		mcp.WithArray("options",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Answer options, between 2 and %d unique values", client.MaxPollOptions)),
+			mcp.WithStringItems(),
+		),
+		mcp.WithNumber("selectable_count",
+			mcp.Description("Maximum number of options a voter may select (default: 1, 0 allows selecting any number of options)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleCreatePoll handles the create_poll tool execution
+func HandleCreatePoll(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.CreatePollParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		params.Question = strings.TrimSpace(params.Question)
+		if params.To == "" || params.Question == "" || len(params.Options) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'to', 'question' and 'options' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'to', 'question' and 'options'"), nil
+		}
+
+		// Validate options: trimmed, non-empty and unique
+		options := make([]string, 0, len(params.Options))
+		seen := make(map[string]bool, len(params.Options))
+		for _, option := range params.Options {
+			option = strings.TrimSpace(option)
+			if option == "" || seen[option] {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "INVALID_PARAMETERS",
+						Message: "Poll options must be non-empty and unique",
+						Details: fmt.Sprintf("invalid option %q", option),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Invalid poll options"), nil
+			}
+			seen[option] = true
+			options = append(options, option)
+		}
+		if len(options) < 2 || len(options) > client.MaxPollOptions {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: fmt.Sprintf("Polls need between 2 and %d options", client.MaxPollOptions),
+					Details: fmt.Sprintf("got %d options", len(options)),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid number of poll options"), nil
+		}
+
+		// Single choice unless specified otherwise
+		selectableCount := 1
+		if params.SelectableCount != nil {
+			selectableCount = *params.SelectableCount
+		}
+		if selectableCount < 0 || selectableCount > len(options) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: fmt.Sprintf("Parameter 'selectable_count' must be between 0 and %d", len(options)),
+					Details: fmt.Sprintf("selectable_count=%d", selectableCount),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid selectable_count"), nil
+		}
+
+		// Send poll using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.CreatePoll(ctx, params.To, params.Question, options, selectableCount)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to create poll",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to create poll"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Poll '%s' with %d options sent successfully to %s (message ID: %s). You are now subscribed to notifications from this chat.", params.Question, len(options), params.To, response.MessageID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
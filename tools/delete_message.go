@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// DeleteMessageTool creates and returns the delete_message MCP tool
+func DeleteMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("delete_message",
+		mcp.WithDescription("Delete a message for everyone in the chat. You can delete your own messages in any chat; as a group admin you can also delete messages of other participants. The original content stays available via get_chat_history with 'include_deleted'. Requires authentication."),
+		mcp.WithString("chat",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat containing the message (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the message to delete"),
+		),
+		mcp.WithString("sender",
+			mcp.Description("Optional JID of the message author. Only needed if the message is not in the stored chat history"),
+		),
+	)
+
+	return tool
+}
+
+// HandleDeleteMessage handles the delete_message tool execution
+func HandleDeleteMessage(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.DeleteMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Chat == "" || params.MessageID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'chat' and 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'chat' and 'message_id'"), nil
+		}
+
+		// Delete message using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.DeleteMessage(ctx, params.Chat, params.MessageID, params.Sender)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "DELETE_FAILED",
+					Message: "Failed to delete message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to delete message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Message %s deleted for everyone.", params.MessageID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
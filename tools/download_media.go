@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// DownloadMediaTool creates and returns the download_media MCP tool
+func DownloadMediaTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("download_media",
+		mcp.WithDescription("Download the media of an image, video, audio, sticker or document message. The file is decrypted on first request and cached privately on the server. Returns the content as base64 for files up to 16 MiB and a resource link with an unguessable URL for all files, which expires after a day by default. Requires authentication."),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the media message, as returned by get_chat_history or get_unread_messages"),
+		),
+		mcp.WithBoolean("include_deleted",
+			mcp.Description("Return the media of messages deleted for everyone (for compliance), like include_deleted of get_chat_history. By default their media is refused"),
+		),
+	)
+
+	return tool
+}
+
+// HandleDownloadMedia handles the download_media tool execution
+func HandleDownloadMedia(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.DownloadMediaParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.MessageID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'message_id'"), nil
+		}
+
+		response, data, err := whatsappClient.DownloadMedia(ctx, params.MessageID, params.IncludeDeleted)
+		if errors.Is(err, database.ErrMediaNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MEDIA_NOT_FOUND",
+					Message: "No downloadable media found for this message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "No media found for message"), nil
+		}
+		if errors.Is(err, database.ErrMessageDeleted) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MESSAGE_DELETED",
+					Message: "The message was deleted for everyone, pass include_deleted to download its media",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Message was deleted"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "DOWNLOAD_FAILED",
+					Message: "Failed to download media",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to download media"), nil
+		}
+
+		name := response.FileName
+		if name == "" {
+			name = fmt.Sprintf("WhatsApp %s %s", response.MessageType, response.MessageID)
+		}
+
+		// Create content with text, inline media (if small enough) and resource link
+		content := []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Downloaded %s media (%s, %d bytes) from message %s.", response.MessageType, response.MimeType, response.FileLength, response.MessageID)),
+		}
+		if len(data) <= media.MaxInlineSize {
+			encoded := base64.StdEncoding.EncodeToString(data)
+			switch response.MessageType {
+			case types.MessageTypeImage, types.MessageTypeSticker:
+				content = append(content, mcp.NewImageContent(encoded, response.MimeType))
+			case types.MessageTypeAudio, types.MessageTypeVoice:
+				content = append(content, mcp.NewAudioContent(encoded, response.MimeType))
+			default:
+				content = append(content, mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+					URI:      "whatsapp://media/" + response.MessageID,
+					MIMEType: response.MimeType,
+					Blob:     encoded,
+				}))
+			}
+		}
+		if response.URL != "" {
+			content = append(content, mcp.NewResourceLink(
+				response.URL,
+				name,
+				fmt.Sprintf("Media of WhatsApp message %s", response.MessageID),
+				response.MimeType,
+			))
+		}
+
+		return &mcp.CallToolResult{
+			Content:           content,
+			StructuredContent: response,
+			IsError:           false,
+		}, nil
+	}
+}
@@ -0,0 +1,134 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// EditLabelTool creates and returns the edit_label MCP tool
+func EditLabelTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("edit_label",
+		mcp.WithDescription("Rename, recolor or delete a WhatsApp Business label. Changes are synced to the phone and all linked devices. Deleting a label removes it from all chats and messages. Requires authentication."),
+		mcp.WithString("label_id",
+			mcp.Required(),
+			mcp.Description("ID of the label, see get_labels"),
+		),
+		mcp.WithString("name",
+			mcp.Description("New name of the label (default: unchanged)"),
+		),
+		mcp.WithNumber("color",
+			mcp.Description("New color of the label, 0 to 19 (default: unchanged)"),
+		),
+		mcp.WithBoolean("delete",
+			mcp.Description("true to delete the label"),
+		),
+	)
+
+	return tool
+}
+
+// HandleEditLabel handles the edit_label tool execution
+func HandleEditLabel(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.EditLabelParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.LabelID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'label_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'label_id'"), nil
+		}
+
+		// Validate label color
+		if params.Color != nil && (*params.Color < 0 || *params.Color >= client.LabelColors) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'color' must be between 0 and 19",
+					Details: fmt.Sprintf("color=%d", *params.Color),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid label color"), nil
+		}
+
+		// Edit label using client interface
+		response, err := whatsappClient.EditLabel(ctx, params.LabelID, params.Name, params.Color, params.Delete)
+		if errors.Is(err, client.ErrLabelsNotSynced) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABELS_NOT_SYNCED",
+					Message: "Labels could not be synced from the phone yet, try again later",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Labels not synced"), nil
+		}
+		if errors.Is(err, client.ErrLabelNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABEL_NOT_FOUND",
+					Message: "The label does not exist, use get_labels to list labels",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Label not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to edit label",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to edit label"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Updated label %s: %q, color %d.", response.Label.ID, response.Label.Name, response.Label.Color)
+		if response.Deleted {
+			fallbackText = fmt.Sprintf("Deleted label %s (%q).", response.Label.ID, response.Label.Name)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// EditMessageTool creates and returns the edit_message MCP tool
+func EditMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("edit_message",
+		mcp.WithDescription("Edit the text of a text message you sent. WhatsApp only allows edits within 20 minutes after sending. The previous text is kept in the message history for auditing. Requires authentication."),
+		mcp.WithString("chat",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat containing the message (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of your own text message to edit, as returned by send_message"),
+		),
+		mcp.WithString("text",
+			mcp.Required(),
+			mcp.Description("New message text"),
+		),
+	)
+
+	return tool
+}
+
+// HandleEditMessage handles the edit_message tool execution
+func HandleEditMessage(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.EditMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Chat == "" || params.MessageID == "" || params.Text == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'chat', 'message_id' and 'text' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'chat', 'message_id' and 'text'"), nil
+		}
+
+		// Edit message using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.EditMessage(ctx, params.Chat, params.MessageID, params.Text)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "EDIT_FAILED",
+					Message: "Failed to edit message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to edit message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Message %s edited successfully.", params.MessageID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetAllChatsTool creates and returns the get_all_chats MCP tool
+func GetAllChatsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_all_chats",
+		mcp.WithDescription("List all chats with their name, type, last message, unread count and whether they are archived, pinned or muted. Chats come from the stored messages and the history synced from the phone."),
+		mcp.WithString("type",
+			mcp.Description("Only list chats of this type: 'direct', 'group' or 'broadcast' (default: all chats)"),
+		),
+		mcp.WithString("label",
+			mcp.Description("Only list chats with the label of this ID, see get_labels (business accounts)"),
+		),
+		mcp.WithString("sort_by",
+			mcp.Description("Sort order: 'recent' (default, pinned chats first, then by last message), 'unread' (most unread messages first) or 'name'"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("Maximum number of chats to retrieve (default: 50, max: 500)"),
+		),
+		mcp.WithNumber("offset",
+			mcp.Description("Number of chats to skip, for pagination (default: 0)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetAllChats handles the get_all_chats tool execution
+func HandleGetAllChats(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetAllChatsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Validate chat type
+		switch params.Type {
+		case "", types.ChatTypeDirect, types.ChatTypeGroup, types.ChatTypeBroadcast:
+		default:
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'type' must be 'direct', 'group' or 'broadcast'",
+					Details: fmt.Sprintf("type=%s", params.Type),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid chat type"), nil
+		}
+
+		// Validate sort order
+		if params.SortBy == "" {
+			params.SortBy = "recent"
+		}
+		if params.SortBy != "recent" && params.SortBy != "unread" && params.SortBy != "name" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'sort_by' must be 'recent', 'unread' or 'name'",
+					Details: fmt.Sprintf("sort_by=%s", params.SortBy),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid sort order"), nil
+		}
+
+		// Set default count if not provided or invalid
+		if params.Count <= 0 {
+			params.Count = 50
+		}
+
+		// Limit maximum count to prevent excessive data retrieval
+		if params.Count > 500 {
+			params.Count = 500
+		}
+
+		if params.Offset < 0 {
+			params.Offset = 0
+		}
+
+		// Retrieve chats (filtered and sorted at database level)
+		response, err := whatsappClient.GetAllChats(params.Type, params.Label, params.SortBy, params.Count, params.Offset)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get chats",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get chats"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		var lines []string
+		for _, chat := range response.Chats {
+			name := chat.Name
+			if name == "" {
+				name = "(no name)"
+			}
+			line := fmt.Sprintf("- %s (%s, %s)", name, chat.JID, chat.Type)
+			var flags []string
+			if chat.UnreadCount > 0 {
+				flags = append(flags, fmt.Sprintf("%d unread", chat.UnreadCount))
+			}
+			if chat.IsPinned {
+				flags = append(flags, "pinned")
+			}
+			if chat.IsArchived {
+				flags = append(flags, "archived")
+			}
+			if chat.IsMuted {
+				flags = append(flags, "muted")
+			}
+			if len(flags) > 0 {
+				line += " [" + strings.Join(flags, ", ") + "]"
+			}
+			if chat.LastMessageText != "" {
+				line += ": " + chat.LastMessageText
+			}
+			lines = append(lines, line)
+		}
+		fallbackText := fmt.Sprintf("Found %d of %d chat(s)", response.Count, response.Total)
+		if len(lines) > 0 {
+			fallbackText += ":\n" + strings.Join(lines, "\n")
+		}
+		if response.HasMore {
+			fallbackText += fmt.Sprintf("\nMore chats available, use offset %d", params.Offset+response.Count)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetBusinessCatalogTool creates and returns the get_business_catalog MCP tool
+func GetBusinessCatalogTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_business_catalog",
+		mcp.WithDescription("List the products in the catalog of a WhatsApp business account with name, description, price, link and image. Results are paged; pass the returned 'next_cursor' as 'cursor' to get the next page. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("JID of the business account (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("Maximum number of products to return (default: 20, max: 100)"),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("Cursor of the next page, as returned in 'next_cursor' by a previous call"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetBusinessCatalog handles the get_business_catalog tool execution
+func HandleGetBusinessCatalog(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetBusinessCatalogParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jid'"), nil
+		}
+
+		// Set default count if not provided or invalid
+		if params.Count <= 0 {
+			params.Count = 20
+		}
+
+		// Limit maximum count to prevent excessive data retrieval
+		if params.Count > 100 {
+			params.Count = 100
+		}
+
+		// Get catalog page using client interface
+		response, err := whatsappClient.GetBusinessCatalog(ctx, params.JID, params.Count, params.Cursor)
+		if errors.Is(err, client.ErrCatalogNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "CATALOG_NOT_FOUND",
+					Message: "The business has no catalog",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Business has no catalog"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get business catalog",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get business catalog"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		lines := []string{fmt.Sprintf("Found %d product(s) in the catalog of %s.", response.Count, response.JID)}
+		for _, product := range response.Products {
+			line := fmt.Sprintf("- %s (%s)", product.Name, product.ID)
+			if product.Currency != "" {
+				line += fmt.Sprintf(", %.2f %s", product.Price, product.Currency)
+			}
+			lines = append(lines, line)
+		}
+		if response.NextCursor != "" {
+			lines = append(lines, fmt.Sprintf("More products available, use cursor '%s' for the next page.", response.NextCursor))
+		}
+		fallbackText := strings.Join(lines, "\n")
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetBusinessCollectionsTool creates and returns the get_business_collections MCP tool
+func GetBusinessCollectionsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_business_collections",
+		mcp.WithDescription("List the collections of the catalog of a WhatsApp business account, each with its products. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("JID of the business account (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("Maximum number of collections, and of products per collection (default: 20, max: 100)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetBusinessCollections handles the get_business_collections tool execution
+func HandleGetBusinessCollections(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetBusinessCollectionsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jid'"), nil
+		}
+
+		// Set default count if not provided or invalid
+		if params.Count <= 0 {
+			params.Count = 20
+		}
+
+		// Limit maximum count to prevent excessive data retrieval
+		if params.Count > 100 {
+			params.Count = 100
+		}
+
+		// Get collections using client interface
+		response, err := whatsappClient.GetBusinessCollections(ctx, params.JID, params.Count)
+		if errors.Is(err, client.ErrCatalogNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "CATALOG_NOT_FOUND",
+					Message: "The business has no catalog",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Business has no catalog"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get business collections",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get business collections"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		lines := []string{fmt.Sprintf("Found %d collection(s) in the catalog of %s.", response.Count, response.JID)}
+		for _, collection := range response.Collections {
+			lines = append(lines, fmt.Sprintf("- %s (%s): %d product(s)", collection.Name, collection.ID, len(collection.Products)))
+		}
+		fallbackText := strings.Join(lines, "\n")
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,129 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetBusinessProfileTool creates and returns the get_business_profile MCP tool
+func GetBusinessProfileTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_business_profile",
+		mcp.WithDescription("Get the profile of a WhatsApp business account: description, categories, address, email, websites and opening hours. Results are cached for a while; set 'refresh' to query WhatsApp again. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("JID of the business account (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+		),
+		mcp.WithBoolean("refresh",
+			mcp.Description("Query WhatsApp even if a cached result is available"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetBusinessProfile handles the get_business_profile tool execution
+func HandleGetBusinessProfile(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetBusinessProfileParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jid'"), nil
+		}
+
+		// Look up business profile using client interface
+		response, err := whatsappClient.GetBusinessProfile(ctx, params.JID, params.Refresh)
+		if errors.Is(err, client.ErrNotBusiness) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_A_BUSINESS",
+					Message: "The account is not a business account",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not a business account"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get business profile",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get business profile"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		lines := []string{fmt.Sprintf("Business profile of %s:", response.JID)}
+		if response.Description != "" {
+			lines = append(lines, "Description: "+response.Description)
+		}
+		if len(response.Categories) > 0 {
+			var categories []string
+			for _, category := range response.Categories {
+				categories = append(categories, category.Name)
+			}
+			lines = append(lines, "Categories: "+strings.Join(categories, ", "))
+		}
+		if response.Address != "" {
+			lines = append(lines, "Address: "+response.Address)
+		}
+		if response.Email != "" {
+			lines = append(lines, "Email: "+response.Email)
+		}
+		if len(response.Websites) > 0 {
+			lines = append(lines, "Websites: "+strings.Join(response.Websites, ", "))
+		}
+		for _, hours := range response.Hours {
+			line := fmt.Sprintf("Hours %s: %s", hours.DayOfWeek, hours.Mode)
+			if hours.OpenTime != "" || hours.CloseTime != "" {
+				line += fmt.Sprintf(" %s-%s", hours.OpenTime, hours.CloseTime)
+			}
+			lines = append(lines, line)
+		}
+		fallbackText := strings.Join(lines, "\n")
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
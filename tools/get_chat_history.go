@@ -24,6 +24,9 @@ func GetChatHistoryTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool
 		mcp.WithString("before_message_id",
 			mcp.Description("Optional message ID to retrieve messages before this point (for pagination)"),
 		),
+		mcp.WithBoolean("include_deleted",
+			mcp.Description("Return the original content of messages deleted for everyone (for compliance). By default only deleted_at and deleted_by are returned for them"),
+		),
 	)
 
 	return tool
@@ -69,7 +72,7 @@ func HandleGetChatHistory(whatsappClient client.WhatsAppClientInterface) func(ct
 		}
 
 		// Retrieve messages for the specific chat (now filtered at database level)
-		chatMessages := whatsappClient.GetChatMessages(params.Chat, params.Count, params.BeforeMessageID)
+		chatMessages := whatsappClient.GetChatMessages(params.Chat, params.Count, params.BeforeMessageID, params.IncludeDeleted)
 
 		// For now, we'll determine hasMore by checking if we got the full requested count
 		// In a more sophisticated implementation, we could add a method to get total count
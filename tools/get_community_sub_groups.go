@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetCommunitySubGroupsTool creates and returns the get_community_sub_groups MCP tool
+func GetCommunitySubGroupsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_community_sub_groups",
+		mcp.WithDescription("List the groups linked to a WhatsApp community, including its announcement group. Requires authentication and membership in the community."),
+		mcp.WithString("community_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the community, ending with '@g.us' (e.g., '120363012345678901@g.us')"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetCommunitySubGroups handles the get_community_sub_groups tool execution
+func HandleGetCommunitySubGroups(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetCommunitySubGroupsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.CommunityJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'community_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'community_jid'"), nil
+		}
+
+		// Get sub-groups using client interface
+		response, err := whatsappClient.GetCommunitySubGroups(ctx, params.CommunityJID)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The community does not exist or you are not a member",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Community not found"), nil
+		}
+		if errors.Is(err, client.ErrNotCommunity) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_A_COMMUNITY",
+					Message: "The group is a regular group, not a community",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not a community"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get community sub-groups",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get community sub-groups"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		var lines []string
+		for _, group := range response.SubGroups {
+			line := fmt.Sprintf("- %s (%s)", group.Name, group.GroupJID)
+			if group.IsAnnouncement {
+				line += " [announcements]"
+			}
+			lines = append(lines, line)
+		}
+		fallbackText := fmt.Sprintf("Community %s has %d group(s).", params.CommunityJID, response.Count)
+		if len(lines) > 0 {
+			fallbackText += "\n" + strings.Join(lines, "\n")
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
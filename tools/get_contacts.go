@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetContactsTool creates and returns the get_contacts MCP tool
+func GetContactsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_contacts",
+		mcp.WithDescription("List known WhatsApp contacts with their names, optionally searching by name or phone number. Names come from the phone's address book, the names users set for themselves (push names) and verified business names."),
+		mcp.WithString("query",
+			mcp.Description("Optional search for part of a name or phone number (e.g., 'alice' or '+49 151')"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("Maximum number of contacts to retrieve (default: 50, max: 500)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetContacts handles the get_contacts tool execution
+func HandleGetContacts(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetContactsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Set default count if not provided or invalid
+		if params.Count <= 0 {
+			params.Count = 50
+		}
+
+		// Limit maximum count to prevent excessive data retrieval
+		if params.Count > 500 {
+			params.Count = 500
+		}
+
+		// Retrieve contacts (filtered at database level)
+		contacts := whatsappClient.GetContacts(params.Query, params.Count)
+
+		result := types.ContactsResponse{
+			Contacts: contacts,
+			Success:  true,
+			Query:    params.Query,
+			Count:    len(contacts),
+		}
+
+		// Create fallback text for backward compatibility
+		var lines []string
+		for _, contact := range contacts {
+			name := contact.Name
+			if name == "" {
+				name = "(no name)"
+			}
+			lines = append(lines, fmt.Sprintf("- %s (%s)", name, contact.JID))
+		}
+		fallbackText := fmt.Sprintf("Found %d contact(s)", len(contacts))
+		if params.Query != "" {
+			fallbackText += fmt.Sprintf(" matching '%s'", params.Query)
+		}
+		if len(lines) > 0 {
+			fallbackText += ":\n" + strings.Join(lines, "\n")
+		}
+
+		return mcp.NewToolResultStructured(result, fallbackText), nil
+	}
+}
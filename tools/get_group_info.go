@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetGroupInfoTool creates and returns the get_group_info MCP tool
+func GetGroupInfoTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_group_info",
+		mcp.WithDescription("Get detailed information about a WhatsApp group you are a member of: name, description (topic), owner, creation time, all participants with their admin status, and settings such as announce-only (only admins can send), locked (only admins can edit group info), join approval and disappearing messages. Requires authentication."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetGroupInfo handles the get_group_info tool execution
+func HandleGetGroupInfo(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetGroupInfoParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'group_jid'"), nil
+		}
+
+		// Get group info using client interface
+		response, err := whatsappClient.GetGroupInfo(ctx, params.GroupJID)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The group does not exist or you are not a participant",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Group not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get group info",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get group info"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		admins := 0
+		for _, participant := range response.Participants {
+			if participant.IsAdmin || participant.IsSuperAdmin {
+				admins++
+			}
+		}
+		fallbackText := fmt.Sprintf("Group '%s' (%s) has %d participant(s), %d of them admins.", response.Name, response.GroupJID, response.ParticipantCount, admins)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
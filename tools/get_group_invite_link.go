@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetGroupInviteLinkTool creates and returns the get_group_invite_link MCP tool
+func GetGroupInviteLinkTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_group_invite_link",
+		mcp.WithDescription("Get the invite link of a WhatsApp group, or revoke the current link and create a new one with 'reset'. Anyone with the link can join the group (or request to join if approval is required). Requires authentication and usually admin rights in the group."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithBoolean("reset",
+			mcp.Description("Revoke the current invite link and create a new one. The old link stops working immediately"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetGroupInviteLink handles the get_group_invite_link tool execution
+func HandleGetGroupInviteLink(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetGroupInviteLinkParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'group_jid'"), nil
+		}
+
+		// Get invite link using client interface
+		response, err := whatsappClient.GetGroupInviteLink(ctx, params.GroupJID, params.Reset)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The group does not exist or you are not a participant",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Group not found"), nil
+		}
+		if errors.Is(err, client.ErrInviteLinkUnauthorized) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INSUFFICIENT_PERMISSIONS",
+					Message: "Only group admins can get the invite link of this group",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not allowed to get invite link"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get group invite link",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get group invite link"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Invite link of group %s: %s", params.GroupJID, response.InviteLink)
+		if response.Reset {
+			fallbackText = fmt.Sprintf("Invite link of group %s reset, the old link no longer works. New link: %s", params.GroupJID, response.InviteLink)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetGroupJoinRequestsTool creates and returns the get_group_join_requests MCP tool
+func GetGroupJoinRequestsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_group_join_requests",
+		mcp.WithDescription("List pending requests to join a WhatsApp group that requires admin approval. Approve or reject them with update_group_join_requests. Your session is automatically subscribed to the group and receives a 'join_request' notification whenever a new request arrives. Requires authentication and admin rights in the group."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetGroupJoinRequests handles the get_group_join_requests tool execution
+func HandleGetGroupJoinRequests(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetGroupJoinRequestsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'group_jid'"), nil
+		}
+
+		// Get join requests using client interface
+		response, err := whatsappClient.GetGroupJoinRequests(ctx, params.GroupJID)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The group does not exist or you are not a participant",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Group not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get join requests",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get join requests"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Group %s has %d pending join request(s).", params.GroupJID, response.Count)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetLabelsTool creates and returns the get_labels MCP tool
+func GetLabelsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_labels",
+		mcp.WithDescription("List the labels of a WhatsApp Business account with their ID, name, color and the number of chats and messages they are on. Labels are synced from the phone, including the built-in ones like 'New customer' and labels created with create_label."),
+	)
+
+	return tool
+}
+
+// HandleGetLabels handles the get_labels tool execution
+func HandleGetLabels(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Retrieve labels, synced from the phone on first use
+		response, err := whatsappClient.GetLabels()
+		if errors.Is(err, client.ErrLabelsNotSynced) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABELS_NOT_SYNCED",
+					Message: "Labels could not be synced from the phone yet, try again later",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Labels not synced"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get labels",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get labels"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		var lines []string
+		for _, label := range response.Labels {
+			lines = append(lines, fmt.Sprintf("- %s (ID %s): %d chat(s), %d message(s)", label.Name, label.ID, label.ChatCount, label.MessageCount))
+		}
+		fallbackText := fmt.Sprintf("Found %d label(s)", response.Count)
+		if len(lines) > 0 {
+			fallbackText += ":\n" + strings.Join(lines, "\n")
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
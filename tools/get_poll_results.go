@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetPollResultsTool creates and returns the get_poll_results MCP tool
+func GetPollResultsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_poll_results",
+		mcp.WithDescription("Get the current results of a WhatsApp poll: vote counts per option and who voted for what. Works for polls created with create_poll and polls received in chats. Requires authentication."),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the poll message"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetPollResults handles the get_poll_results tool execution
+func HandleGetPollResults(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetPollResultsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.MessageID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'message_id'"), nil
+		}
+
+		// Get poll results using client interface
+		response, err := whatsappClient.GetPollResults(ctx, params.MessageID)
+		if errors.Is(err, database.ErrPollNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "POLL_NOT_FOUND",
+					Message: "No poll found for this message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Poll not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get poll results",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get poll results"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		tallies := make([]string, 0, len(response.Options))
+		for _, option := range response.Options {
+			tallies = append(tallies, fmt.Sprintf("%s: %d", option.Name, option.Votes))
+		}
+		fallbackText := fmt.Sprintf("Poll '%s' (%d voter(s)): %s", response.Question, response.TotalVoters, strings.Join(tallies, ", "))
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetProfilePictureInfoTool creates and returns the get_profile_picture_info MCP tool
+func GetProfilePictureInfoTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_profile_picture_info",
+		mcp.WithDescription("Get the profile picture of a WhatsApp user or the photo of a group: picture ID and download URL, and optionally the image itself. Results are cached for a while; set 'refresh' to query WhatsApp again. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("JID of the user (e.g., '1234567890@s.whatsapp.net') or group (ending with '@g.us'); phone numbers in international format are accepted as well"),
+		),
+		mcp.WithBoolean("preview",
+			mcp.Description("Get the low resolution preview instead of the full picture"),
+		),
+		mcp.WithBoolean("download",
+			mcp.Description("Download the picture and return it as an image"),
+		),
+		mcp.WithBoolean("refresh",
+			mcp.Description("Query WhatsApp even if a cached result is available"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetProfilePictureInfo handles the get_profile_picture_info tool execution
+func HandleGetProfilePictureInfo(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetProfilePictureInfoParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jid'"), nil
+		}
+
+		// Look up profile picture using client interface
+		response, err := whatsappClient.GetProfilePictureInfo(ctx, params.JID, params.Preview, params.Refresh)
+		if errors.Is(err, client.ErrProfilePictureHidden) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INSUFFICIENT_PERMISSIONS",
+					Message: "The user has hidden their profile picture from you",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Profile picture is hidden"), nil
+		}
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The group does not exist or you are not a participant",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Group not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get profile picture",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get profile picture"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("%s has no profile picture.", response.JID)
+		if response.HasPicture {
+			fallbackText = fmt.Sprintf("Profile picture of %s (ID %s): %s", response.JID, response.PictureID, response.URL)
+		}
+
+		if !params.Download || !response.HasPicture {
+			return mcp.NewToolResultStructured(response, fallbackText), nil
+		}
+
+		// Download the picture and return it as image content
+		data, err := whatsappClient.DownloadProfilePicture(ctx, response.URL)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "DOWNLOAD_FAILED",
+					Message: "Failed to download profile picture, retry with 'refresh' if the URL has expired",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to download profile picture"), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent(fallbackText),
+			mcp.NewImageContent(base64.StdEncoding.EncodeToString(data), http.DetectContentType(data)),
+		}
+
+		return &mcp.CallToolResult{
+			Content:           content,
+			StructuredContent: response,
+			IsError:           false,
+		}, nil
+	}
+}
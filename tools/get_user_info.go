@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetUserInfoTool creates and returns the get_user_info MCP tool
+func GetUserInfoTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("get_user_info",
+		mcp.WithDescription("Look up WhatsApp users: status (about) text, verified business name, profile picture ID, linked devices, phone number and LID, plus the contact name if known. Results are cached for a while; set 'refresh' to query WhatsApp again. Requires authentication."),
+		mcp.WithArray("jids",
+			mcp.Required(),
+			mcp.Description("JIDs of the users to look up (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithBoolean("refresh",
+			mcp.Description("Query WhatsApp even if a cached result is available"),
+		),
+	)
+
+	return tool
+}
+
+// HandleGetUserInfo handles the get_user_info tool execution
+func HandleGetUserInfo(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.GetUserInfoParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if len(params.JIDs) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jids' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jids'"), nil
+		}
+
+		// Look up users using client interface
+		response, err := whatsappClient.GetUserInfo(ctx, params.JIDs, params.Refresh)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to get user info",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to get user info"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		var lines []string
+		for jid, user := range response.Users {
+			line := fmt.Sprintf("- %s", jid)
+			if user.Name != "" {
+				line += fmt.Sprintf(" (%s)", user.Name)
+			}
+			if user.VerifiedName != "" {
+				line += fmt.Sprintf(", business: %s", user.VerifiedName)
+			}
+			if user.Status != "" {
+				line += fmt.Sprintf(", status: %s", user.Status)
+			}
+			line += fmt.Sprintf(", %d device(s)", len(user.Devices))
+			lines = append(lines, line)
+		}
+		sort.Strings(lines)
+		fallbackText := fmt.Sprintf("Found %d of %d user(s) on WhatsApp.", response.Count, len(params.JIDs))
+		if len(lines) > 0 {
+			fallbackText += "\n" + strings.Join(lines, "\n")
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// JoinGroupWithInviteTool creates and returns the join_group_with_invite MCP tool
+func JoinGroupWithInviteTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("join_group_with_invite",
+		mcp.WithDescription("Join a WhatsApp group using a received group invite message (message_type 'group_invite' in get_chat_history). Invite messages are personal and expire after some days. Requires authentication. Your session is automatically subscribed to notifications from the joined group."),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the received group invite message"),
+		),
+	)
+
+	return tool
+}
+
+// HandleJoinGroupWithInvite handles the join_group_with_invite tool execution
+func HandleJoinGroupWithInvite(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.JoinGroupWithInviteParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.MessageID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'message_id'"), nil
+		}
+
+		// Join group using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.JoinGroupWithInvite(ctx, params.MessageID)
+		if errors.Is(err, database.ErrMessageDeleted) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MESSAGE_DELETED",
+					Message: "The invite message was deleted for everyone and can no longer be used",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invite message was deleted"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "JOIN_FAILED",
+					Message: "Failed to join group",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to join group"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Joined group '%s' (%s). You are now subscribed to notifications from this group.", response.Name, response.GroupJID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// JoinGroupWithLinkTool creates and returns the join_group_with_link MCP tool
+func JoinGroupWithLinkTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("join_group_with_link",
+		mcp.WithDescription("Join a WhatsApp group using an invite link. If the group requires admin approval, a join request is sent instead and the result is marked as pending. Use preview_group_invite first to check the group. Requires authentication. Your session is automatically subscribed to notifications from the joined group."),
+		mcp.WithString("invite_link",
+			mcp.Required(),
+			mcp.Description("Group invite link (e.g., 'https://chat.whatsapp.com/AbCdEfGhIjK') or just the invite code"),
+		),
+	)
+
+	return tool
+}
+
+// HandleJoinGroupWithLink handles the join_group_with_link tool execution
+func HandleJoinGroupWithLink(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.JoinGroupWithLinkParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.InviteLink == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'invite_link' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'invite_link'"), nil
+		}
+
+		// Join group using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.JoinGroupWithLink(ctx, params.InviteLink)
+		if errors.Is(err, client.ErrInviteLinkInvalid) || errors.Is(err, client.ErrInviteLinkRevoked) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_INVITE",
+					Message: "The invite link is invalid or has been revoked",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid invite link"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "JOIN_FAILED",
+					Message: "Failed to join group",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to join group"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Joined group '%s' (%s). You are now subscribed to notifications from this group.", response.Name, response.GroupJID)
+		if response.PendingApproval {
+			fallbackText = fmt.Sprintf("Requested to join group '%s' (%s), waiting for admin approval.", response.Name, response.GroupJID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
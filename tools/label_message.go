@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// LabelMessageTool creates and returns the label_message MCP tool
+func LabelMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("label_message",
+		mcp.WithDescription("Add a WhatsApp Business label to a message or remove it. The change is synced to the phone and all linked devices. Labels of messages are shown in get_chat_history. Requires authentication."),
+		mcp.WithString("chat",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat containing the message"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the message"),
+		),
+		mcp.WithString("label_id",
+			mcp.Required(),
+			mcp.Description("ID of the label, see get_labels"),
+		),
+		mcp.WithBoolean("labeled",
+			mcp.Required(),
+			mcp.Description("true to add the label, false to remove it"),
+		),
+	)
+
+	return tool
+}
+
+// HandleLabelMessage handles the label_message tool execution
+func HandleLabelMessage(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.LabelMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Chat == "" || params.MessageID == "" || params.LabelID == "" || params.Labeled == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'chat', 'message_id', 'label_id' and 'labeled' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'chat', 'message_id', 'label_id' and 'labeled'"), nil
+		}
+
+		// Label message using client interface
+		response, err := whatsappClient.LabelMessage(ctx, params.Chat, params.MessageID, params.LabelID, *params.Labeled)
+		if errors.Is(err, client.ErrLabelsNotSynced) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABELS_NOT_SYNCED",
+					Message: "Labels could not be synced from the phone yet, try again later",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Labels not synced"), nil
+		}
+		if errors.Is(err, client.ErrLabelNotFound) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "LABEL_NOT_FOUND",
+					Message: "The label does not exist, use get_labels to list labels",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Label not found"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to label message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to label message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Removed label %s from message %s.", response.LabelID, response.MessageID)
+		if response.Labeled {
+			fallbackText = fmt.Sprintf("Added label %s to message %s.", response.LabelID, response.MessageID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MarkChatUnreadTool creates and returns the mark_chat_unread MCP tool
+func MarkChatUnreadTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("mark_chat_unread",
+		mcp.WithDescription("Mark a WhatsApp chat as unread, or as read again. The change is synced to the phone and all linked devices, where the chat shows an unread badge. Marking a chat as read also marks its messages as read. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithBoolean("unread",
+			mcp.Required(),
+			mcp.Description("true to mark the chat as unread, false to mark it as read"),
+		),
+	)
+
+	return tool
+}
+
+// HandleMarkChatUnread handles the mark_chat_unread tool execution
+func HandleMarkChatUnread(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.MarkChatUnreadParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" || params.Unread == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'jid' and 'unread' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'jid' and 'unread'"), nil
+		}
+
+		// Update unread status using client interface
+		response, err := whatsappClient.MarkChatUnread(ctx, params.JID, *params.Unread)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update unread status",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update unread status"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Marked chat %s as read.", response.JID)
+		if *params.Unread {
+			fallbackText = fmt.Sprintf("Marked chat %s as unread.", response.JID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MuteChatTool creates and returns the mute_chat MCP tool
+func MuteChatTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("mute_chat",
+		mcp.WithDescription("Mute a WhatsApp chat for a number of hours or forever, or unmute it. Muted chats give no notifications on the phone. The change is synced to the phone and all linked devices. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithBoolean("muted",
+			mcp.Required(),
+			mcp.Description("true to mute the chat, false to unmute it"),
+		),
+		mcp.WithNumber("duration_hours",
+			mcp.Description("How long to mute the chat in hours, e.g. 8 or 168 for a week (default: forever). Ignored when unmuting"),
+		),
+	)
+
+	return tool
+}
+
+// HandleMuteChat handles the mute_chat tool execution
+func HandleMuteChat(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.MuteChatParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" || params.Muted == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'jid' and 'muted' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'jid' and 'muted'"), nil
+		}
+
+		// Validate mute duration
+		if params.DurationHours < 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'duration_hours' must not be negative",
+					Details: fmt.Sprintf("duration_hours=%v", params.DurationHours),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid mute duration"), nil
+		}
+		duration := time.Duration(params.DurationHours * float64(time.Hour))
+
+		// Update mute status using client interface
+		response, err := whatsappClient.MuteChat(ctx, params.JID, *params.Muted, duration)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update mute status",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update mute status"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Unmuted chat %s.", response.JID)
+		if *params.Muted && duration > 0 {
+			fallbackText = fmt.Sprintf("Muted chat %s for %v hours.", response.JID, params.DurationHours)
+		} else if *params.Muted {
+			fallbackText = fmt.Sprintf("Muted chat %s forever.", response.JID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
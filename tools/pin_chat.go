@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// PinChatTool creates and returns the pin_chat MCP tool
+func PinChatTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("pin_chat",
+		mcp.WithDescription("Pin a WhatsApp chat to the top of the chat list, or unpin it. The change is synced to the phone and all linked devices. WhatsApp allows at most 3 pinned chats. Requires authentication."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithBoolean("pinned",
+			mcp.Required(),
+			mcp.Description("true to pin the chat, false to unpin it"),
+		),
+	)
+
+	return tool
+}
+
+// HandlePinChat handles the pin_chat tool execution
+func HandlePinChat(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.PinChatParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" || params.Pinned == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'jid' and 'pinned' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'jid' and 'pinned'"), nil
+		}
+
+		// Update pin status using client interface
+		response, err := whatsappClient.PinChat(ctx, params.JID, *params.Pinned)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update pin status",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update pin status"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Unpinned chat %s.", response.JID)
+		if *params.Pinned {
+			fallbackText = fmt.Sprintf("Pinned chat %s.", response.JID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
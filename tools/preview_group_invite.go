@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/database"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// PreviewGroupInviteTool creates and returns the preview_group_invite MCP tool
+func PreviewGroupInviteTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("preview_group_invite",
+		mcp.WithDescription("Look at a WhatsApp group before joining it: name, description, size, settings and (partial) participants. Provide either an 'invite_link' or the 'message_id' of a received group invite message. Does not join the group. Requires authentication."),
+		mcp.WithString("invite_link",
+			mcp.Description("Group invite link (e.g., 'https://chat.whatsapp.com/AbCdEfGhIjK') or just the invite code"),
+		),
+		mcp.WithString("message_id",
+			mcp.Description("ID of a received group invite message (message_type 'group_invite' in get_chat_history)"),
+		),
+	)
+
+	return tool
+}
+
+// HandlePreviewGroupInvite handles the preview_group_invite tool execution
+func HandlePreviewGroupInvite(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.PreviewGroupInviteParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if (params.InviteLink == "") == (params.MessageID == "") {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Exactly one of 'invite_link' or 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Provide either 'invite_link' or 'message_id'"), nil
+		}
+
+		// Resolve the invite using client interface
+		var response *types.GroupInfoResponse
+		var err error
+		if params.InviteLink != "" {
+			response, err = whatsappClient.GetGroupInfoFromLink(ctx, params.InviteLink)
+		} else {
+			response, err = whatsappClient.GetGroupInfoFromInvite(ctx, params.MessageID)
+		}
+		if errors.Is(err, client.ErrInviteLinkInvalid) || errors.Is(err, client.ErrInviteLinkRevoked) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_INVITE",
+					Message: "The invite link is invalid or has been revoked",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid invite link"), nil
+		}
+		if errors.Is(err, database.ErrMessageDeleted) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MESSAGE_DELETED",
+					Message: "The invite message was deleted for everyone and can no longer be used",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invite message was deleted"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "QUERY_FAILED",
+					Message: "Failed to resolve group invite",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to resolve group invite"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Group '%s' (%s) has %d participant(s).", response.Name, response.GroupJID, response.ParticipantCount)
+		if response.JoinApprovalRequired {
+			fallbackText += " Joining requires admin approval."
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -3,13 +3,14 @@ package tools
 import (
 	"log"
 	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
 	"whatsmeow-mcp/internal/qrcode"
 
 	"github.com/mark3labs/mcp-go/server"
 )
 
 // RegisterAllTools registers all available WhatsApp MCP tools with the server
-func RegisterAllTools(mcpServer *server.MCPServer, whatsappClient client.WhatsAppClientInterface, qrGenerator *qrcode.QRCodeGenerator) {
+func RegisterAllTools(mcpServer *server.MCPServer, whatsappClient client.WhatsAppClientInterface, qrGenerator *qrcode.QRCodeGenerator, mediaStore *media.Store) {
 	// Register is_logged_in tool
 	isLoggedInTool := IsLoggedInTool(whatsappClient)
 	mcpServer.AddTool(isLoggedInTool, HandleIsLoggedIn(whatsappClient))
@@ -22,10 +23,134 @@ func RegisterAllTools(mcpServer *server.MCPServer, whatsappClient client.WhatsAp
 	sendMessageTool := SendMessageTool(whatsappClient)
 	mcpServer.AddTool(sendMessageTool, HandleSendMessage(whatsappClient))
 
+	// Register send_image_message tool
+	sendImageMessageTool := SendImageMessageTool(whatsappClient)
+	mcpServer.AddTool(sendImageMessageTool, HandleSendImageMessage(whatsappClient, mediaStore))
+
+	// Register send_document_message tool
+	sendDocumentMessageTool := SendDocumentMessageTool(whatsappClient)
+	mcpServer.AddTool(sendDocumentMessageTool, HandleSendDocumentMessage(whatsappClient, mediaStore))
+
+	// Register send_audio_message tool
+	sendAudioMessageTool := SendAudioMessageTool(whatsappClient)
+	mcpServer.AddTool(sendAudioMessageTool, HandleSendAudioMessage(whatsappClient, mediaStore))
+
+	// Register send_video_message tool
+	sendVideoMessageTool := SendVideoMessageTool(whatsappClient)
+	mcpServer.AddTool(sendVideoMessageTool, HandleSendVideoMessage(whatsappClient, mediaStore))
+
+	// Register send_location_message tool
+	sendLocationMessageTool := SendLocationMessageTool(whatsappClient)
+	mcpServer.AddTool(sendLocationMessageTool, HandleSendLocationMessage(whatsappClient))
+
+	// Register send_contact_message tool
+	sendContactMessageTool := SendContactMessageTool(whatsappClient)
+	mcpServer.AddTool(sendContactMessageTool, HandleSendContactMessage(whatsappClient))
+
+	// Register send_reaction tool
+	sendReactionTool := SendReactionTool(whatsappClient)
+	mcpServer.AddTool(sendReactionTool, HandleSendReaction(whatsappClient))
+
+	// Register edit_message tool
+	editMessageTool := EditMessageTool(whatsappClient)
+	mcpServer.AddTool(editMessageTool, HandleEditMessage(whatsappClient))
+
+	// Register delete_message tool
+	deleteMessageTool := DeleteMessageTool(whatsappClient)
+	mcpServer.AddTool(deleteMessageTool, HandleDeleteMessage(whatsappClient))
+
+	// Register create_poll tool
+	createPollTool := CreatePollTool(whatsappClient)
+	mcpServer.AddTool(createPollTool, HandleCreatePoll(whatsappClient))
+
+	// Register get_poll_results tool
+	getPollResultsTool := GetPollResultsTool(whatsappClient)
+	mcpServer.AddTool(getPollResultsTool, HandleGetPollResults(whatsappClient))
+
+	// Register download_media tool
+	downloadMediaTool := DownloadMediaTool(whatsappClient)
+	mcpServer.AddTool(downloadMediaTool, HandleDownloadMedia(whatsappClient))
+
 	// Register is_on_whatsapp tool
 	isOnWhatsappTool := IsOnWhatsappTool(whatsappClient)
 	mcpServer.AddTool(isOnWhatsappTool, HandleIsOnWhatsapp(whatsappClient))
 
+	// Register get_contacts tool
+	getContactsTool := GetContactsTool(whatsappClient)
+	mcpServer.AddTool(getContactsTool, HandleGetContacts(whatsappClient))
+
+	// Register get_user_info tool
+	getUserInfoTool := GetUserInfoTool(whatsappClient)
+	mcpServer.AddTool(getUserInfoTool, HandleGetUserInfo(whatsappClient))
+
+	// Register get_profile_picture_info tool
+	getProfilePictureInfoTool := GetProfilePictureInfoTool(whatsappClient)
+	mcpServer.AddTool(getProfilePictureInfoTool, HandleGetProfilePictureInfo(whatsappClient))
+
+	// Register get_business_profile tool
+	getBusinessProfileTool := GetBusinessProfileTool(whatsappClient)
+	mcpServer.AddTool(getBusinessProfileTool, HandleGetBusinessProfile(whatsappClient))
+
+	// Register get_business_catalog tool
+	getBusinessCatalogTool := GetBusinessCatalogTool(whatsappClient)
+	mcpServer.AddTool(getBusinessCatalogTool, HandleGetBusinessCatalog(whatsappClient))
+
+	// Register get_business_collections tool
+	getBusinessCollectionsTool := GetBusinessCollectionsTool(whatsappClient)
+	mcpServer.AddTool(getBusinessCollectionsTool, HandleGetBusinessCollections(whatsappClient))
+
+	// Register get_labels tool
+	getLabelsTool := GetLabelsTool(whatsappClient)
+	mcpServer.AddTool(getLabelsTool, HandleGetLabels(whatsappClient))
+
+	// Register create_label tool
+	createLabelTool := CreateLabelTool(whatsappClient)
+	mcpServer.AddTool(createLabelTool, HandleCreateLabel(whatsappClient))
+
+	// Register edit_label tool
+	editLabelTool := EditLabelTool(whatsappClient)
+	mcpServer.AddTool(editLabelTool, HandleEditLabel(whatsappClient))
+
+	// Register label_chat tool
+	labelChatTool := LabelChatTool(whatsappClient)
+	mcpServer.AddTool(labelChatTool, HandleLabelChat(whatsappClient))
+
+	// Register label_message tool
+	labelMessageTool := LabelMessageTool(whatsappClient)
+	mcpServer.AddTool(labelMessageTool, HandleLabelMessage(whatsappClient))
+
+	// Register send_presence tool
+	sendPresenceTool := SendPresenceTool(whatsappClient)
+	mcpServer.AddTool(sendPresenceTool, HandleSendPresence(whatsappClient))
+
+	// Register send_chat_presence tool
+	sendChatPresenceTool := SendChatPresenceTool(whatsappClient)
+	mcpServer.AddTool(sendChatPresenceTool, HandleSendChatPresence(whatsappClient))
+
+	// Register subscribe_presence tool
+	subscribePresenceTool := SubscribePresenceTool(whatsappClient)
+	mcpServer.AddTool(subscribePresenceTool, HandleSubscribePresence(whatsappClient))
+
+	// Register get_all_chats tool
+	getAllChatsTool := GetAllChatsTool(whatsappClient)
+	mcpServer.AddTool(getAllChatsTool, HandleGetAllChats(whatsappClient))
+
+	// Register archive_chat tool
+	archiveChatTool := ArchiveChatTool(whatsappClient)
+	mcpServer.AddTool(archiveChatTool, HandleArchiveChat(whatsappClient))
+
+	// Register pin_chat tool
+	pinChatTool := PinChatTool(whatsappClient)
+	mcpServer.AddTool(pinChatTool, HandlePinChat(whatsappClient))
+
+	// Register mute_chat tool
+	muteChatTool := MuteChatTool(whatsappClient)
+	mcpServer.AddTool(muteChatTool, HandleMuteChat(whatsappClient))
+
+	// Register mark_chat_unread tool
+	markChatUnreadTool := MarkChatUnreadTool(whatsappClient)
+	mcpServer.AddTool(markChatUnreadTool, HandleMarkChatUnread(whatsappClient))
+
 	// Register get_chat_history tool
 	getChatHistoryTool := GetChatHistoryTool(whatsappClient)
 	mcpServer.AddTool(getChatHistoryTool, HandleGetChatHistory(whatsappClient))
@@ -38,12 +163,133 @@ func RegisterAllTools(mcpServer *server.MCPServer, whatsappClient client.WhatsAp
 	markMessagesAsReadTool := MarkMessagesAsReadTool(whatsappClient)
 	mcpServer.AddTool(markMessagesAsReadTool, HandleMarkMessagesAsRead(whatsappClient))
 
-	log.Println("Successfully registered 7 WhatsApp MCP tools:")
+	// Register create_group tool
+	createGroupTool := CreateGroupTool(whatsappClient)
+	mcpServer.AddTool(createGroupTool, HandleCreateGroup(whatsappClient))
+
+	// Register get_group_info tool
+	getGroupInfoTool := GetGroupInfoTool(whatsappClient)
+	mcpServer.AddTool(getGroupInfoTool, HandleGetGroupInfo(whatsappClient))
+
+	// Register update_group_participants tool
+	updateGroupParticipantsTool := UpdateGroupParticipantsTool(whatsappClient)
+	mcpServer.AddTool(updateGroupParticipantsTool, HandleUpdateGroupParticipants(whatsappClient))
+
+	// Register get_group_invite_link tool
+	getGroupInviteLinkTool := GetGroupInviteLinkTool(whatsappClient)
+	mcpServer.AddTool(getGroupInviteLinkTool, HandleGetGroupInviteLink(whatsappClient))
+
+	// Register preview_group_invite tool
+	previewGroupInviteTool := PreviewGroupInviteTool(whatsappClient)
+	mcpServer.AddTool(previewGroupInviteTool, HandlePreviewGroupInvite(whatsappClient))
+
+	// Register join_group_with_link tool
+	joinGroupWithLinkTool := JoinGroupWithLinkTool(whatsappClient)
+	mcpServer.AddTool(joinGroupWithLinkTool, HandleJoinGroupWithLink(whatsappClient))
+
+	// Register join_group_with_invite tool
+	joinGroupWithInviteTool := JoinGroupWithInviteTool(whatsappClient)
+	mcpServer.AddTool(joinGroupWithInviteTool, HandleJoinGroupWithInvite(whatsappClient))
+
+	// Register get_group_join_requests tool
+	getGroupJoinRequestsTool := GetGroupJoinRequestsTool(whatsappClient)
+	mcpServer.AddTool(getGroupJoinRequestsTool, HandleGetGroupJoinRequests(whatsappClient))
+
+	// Register update_group_join_requests tool
+	updateGroupJoinRequestsTool := UpdateGroupJoinRequestsTool(whatsappClient)
+	mcpServer.AddTool(updateGroupJoinRequestsTool, HandleUpdateGroupJoinRequests(whatsappClient))
+
+	// Register set_group_join_approval tool
+	setGroupJoinApprovalTool := SetGroupJoinApprovalTool(whatsappClient)
+	mcpServer.AddTool(setGroupJoinApprovalTool, HandleSetGroupJoinApproval(whatsappClient))
+
+	// Register leave_group tool
+	leaveGroupTool := LeaveGroupTool(whatsappClient)
+	mcpServer.AddTool(leaveGroupTool, HandleLeaveGroup(whatsappClient))
+
+	// Register set_group_name tool
+	setGroupNameTool := SetGroupNameTool(whatsappClient)
+	mcpServer.AddTool(setGroupNameTool, HandleSetGroupName(whatsappClient))
+
+	// Register set_group_description tool
+	setGroupDescriptionTool := SetGroupDescriptionTool(whatsappClient)
+	mcpServer.AddTool(setGroupDescriptionTool, HandleSetGroupDescription(whatsappClient))
+
+	// Register set_group_photo tool
+	setGroupPhotoTool := SetGroupPhotoTool(whatsappClient)
+	mcpServer.AddTool(setGroupPhotoTool, HandleSetGroupPhoto(whatsappClient, mediaStore))
+
+	// Register create_community tool
+	createCommunityTool := CreateCommunityTool(whatsappClient)
+	mcpServer.AddTool(createCommunityTool, HandleCreateCommunity(whatsappClient))
+
+	// Register get_community_sub_groups tool
+	getCommunitySubGroupsTool := GetCommunitySubGroupsTool(whatsappClient)
+	mcpServer.AddTool(getCommunitySubGroupsTool, HandleGetCommunitySubGroups(whatsappClient))
+
+	// Register update_community_groups tool
+	updateCommunityGroupsTool := UpdateCommunityGroupsTool(whatsappClient)
+	mcpServer.AddTool(updateCommunityGroupsTool, HandleUpdateCommunityGroups(whatsappClient))
+
+	// Register send_community_announcement tool
+	sendCommunityAnnouncementTool := SendCommunityAnnouncementTool(whatsappClient)
+	mcpServer.AddTool(sendCommunityAnnouncementTool, HandleSendCommunityAnnouncement(whatsappClient))
+
+	log.Println("Successfully registered 56 WhatsApp MCP tools:")
 	log.Println("  - is_logged_in: Check authentication status")
 	log.Println("  - get_qr_code: Generate QR code for login")
 	log.Println("  - send_message: Send text messages")
+	log.Println("  - send_image_message: Send images with optional caption")
+	log.Println("  - send_document_message: Send documents and files")
+	log.Println("  - send_audio_message: Send audio files and voice notes")
+	log.Println("  - send_video_message: Send videos and GIFs")
+	log.Println("  - send_location_message: Send location pins")
+	log.Println("  - send_contact_message: Send contact cards")
+	log.Println("  - send_reaction: React to messages with emoji")
+	log.Println("  - edit_message: Edit sent text messages")
+	log.Println("  - delete_message: Delete messages for everyone")
+	log.Println("  - create_poll: Create polls in chats and groups")
+	log.Println("  - get_poll_results: Get live poll tallies and voters")
+	log.Println("  - download_media: Download media from a message")
 	log.Println("  - is_on_whatsapp: Check phone number registration")
+	log.Println("  - get_contacts: Search contacts by name or phone number")
+	log.Println("  - get_user_info: Look up status, business name and devices of users")
+	log.Println("  - get_profile_picture_info: Get profile pictures of users and groups")
+	log.Println("  - get_business_profile: Get the profile of a business account")
+	log.Println("  - get_business_catalog: List the products of a business catalog")
+	log.Println("  - get_business_collections: List the collections of a business catalog")
+	log.Println("  - get_labels: List business labels with their chat and message counts")
+	log.Println("  - create_label: Create business labels")
+	log.Println("  - edit_label: Rename, recolor or delete business labels")
+	log.Println("  - label_chat: Add or remove labels of chats")
+	log.Println("  - label_message: Add or remove labels of messages")
+	log.Println("  - send_presence: Set your global presence")
+	log.Println("  - send_chat_presence: Show typing or recording indicators in a chat")
+	log.Println("  - subscribe_presence: Receive online status and typing notifications of a user")
+	log.Println("  - get_all_chats: List chats with last message, unread count and settings")
+	log.Println("  - archive_chat: Archive or unarchive chats")
+	log.Println("  - pin_chat: Pin or unpin chats")
+	log.Println("  - mute_chat: Mute chats for a while or forever, or unmute them")
+	log.Println("  - mark_chat_unread: Mark chats as unread or read")
 	log.Println("  - get_chat_history: Retrieve chat message history")
 	log.Println("  - get_unread_messages: Retrieve unread messages")
 	log.Println("  - mark_messages_as_read: Mark messages as read in a chat")
+	log.Println("  - create_group: Create groups with participants")
+	log.Println("  - get_group_info: Get group details and participants")
+	log.Println("  - update_group_participants: Add, remove, promote and demote participants")
+	log.Println("  - get_group_invite_link: Get or reset group invite links")
+	log.Println("  - preview_group_invite: Preview a group before joining")
+	log.Println("  - join_group_with_link: Join groups via invite link")
+	log.Println("  - join_group_with_invite: Join groups via invite message")
+	log.Println("  - get_group_join_requests: List pending group join requests")
+	log.Println("  - update_group_join_requests: Approve or reject join requests")
+	log.Println("  - set_group_join_approval: Toggle admin approval for new members")
+	log.Println("  - leave_group: Leave groups")
+	log.Println("  - set_group_name: Rename groups")
+	log.Println("  - set_group_description: Change group descriptions")
+	log.Println("  - set_group_photo: Change group photos")
+	log.Println("  - create_community: Create communities")
+	log.Println("  - get_community_sub_groups: List the groups of a community")
+	log.Println("  - update_community_groups: Link and unlink community groups")
+	log.Println("  - send_community_announcement: Post to the announcement group of a community")
 }
@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendAudioMessageTool creates and returns the send_audio_message MCP tool
+func SendAudioMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_audio_message",
+		mcp.WithDescription("Send an audio file or a push-to-talk voice note. Provide exactly one of 'audio_base64', 'audio_path' or 'audio_url'. Voice notes (ptt=true) must be OGG/Opus; their duration and waveform are computed automatically. Regular audio may also be MP3 or M4A. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithString("audio_base64",
+			mcp.Description("Base64 encoded audio content, optionally as a data URI"),
+		),
+		mcp.WithString("audio_path",
+			mcp.Description("Path to an audio file on the server filesystem, inside the MEDIA_ROOT directory (relative paths are relative to it)"),
+		),
+		mcp.WithString("audio_url",
+			mcp.Description("URL of an audio file served from this server's /static endpoint"),
+		),
+		mcp.WithBoolean("ptt",
+			mcp.Description("Send as a push-to-talk voice note (default: false). Requires OGG/Opus audio"),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendAudioMessage handles the send_audio_message tool execution
+func HandleSendAudioMessage(whatsappClient client.WhatsAppClientInterface, mediaStore *media.Store) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendAudioMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'to' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'to'"), nil
+		}
+
+		// Load audio from the provided source
+		file, err := mediaStore.Load(params.AudioBase64, params.AudioPath, params.AudioURL)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_MEDIA",
+					Message: "Provide exactly one readable audio file via 'audio_base64', 'audio_path' or 'audio_url'",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to load audio"), nil
+		}
+
+		// Send audio using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendAudioMessage(ctx, params.To, file.Data, params.PTT, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send audio message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send audio message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		kind := "Audio"
+		if response.PTT {
+			kind = "Voice note"
+		}
+		fallbackText := fmt.Sprintf("%s sent successfully to %s. You are now subscribed to notifications from this chat.", kind, params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
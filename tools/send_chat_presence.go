@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendChatPresenceTool creates and returns the send_chat_presence MCP tool
+func SendChatPresenceTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_chat_presence",
+		mcp.WithDescription("Show a typing ('composing') or voice recording ('recording') indicator in a WhatsApp chat, or clear it with 'paused'. WhatsApp clears the indicator by itself after a while or when a message is sent. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat, including the other side's typing indicators."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithString("state",
+			mcp.Required(),
+			mcp.Description("Chat state to show: 'composing' (typing), 'recording' (recording a voice message) or 'paused' (stopped)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendChatPresence handles the send_chat_presence tool execution
+func HandleSendChatPresence(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendChatPresenceParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" || params.State == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'jid' and 'state' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'jid' and 'state'"), nil
+		}
+
+		// Validate chat state
+		if params.State != types.ChatStateComposing && params.State != types.ChatStateRecording && params.State != types.ChatStatePaused {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'state' must be 'composing', 'recording' or 'paused'",
+					Details: fmt.Sprintf("state=%s", params.State),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid chat state"), nil
+		}
+
+		// Send chat state using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendChatPresence(ctx, params.JID, params.State)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send chat presence",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send chat presence"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Sent %s to %s. You are now subscribed to notifications from this chat.", response.State, response.JID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
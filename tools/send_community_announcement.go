@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendCommunityAnnouncementTool creates and returns the send_community_announcement MCP tool
+func SendCommunityAnnouncementTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_community_announcement",
+		mcp.WithDescription("Post a text message to the announcement group of a WhatsApp community, reaching all community members. Requires authentication and admin rights in the community. Like send_message, your session is automatically subscribed to notifications from the announcement group."),
+		mcp.WithString("community_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the community, ending with '@g.us' (e.g., '120363012345678901@g.us')"),
+		),
+		mcp.WithString("text",
+			mcp.Required(),
+			mcp.Description("Text of the announcement"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendCommunityAnnouncement handles the send_community_announcement tool execution
+func HandleSendCommunityAnnouncement(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendCommunityAnnouncementParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.CommunityJID == "" || params.Text == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'community_jid' and 'text' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'community_jid' and 'text'"), nil
+		}
+
+		// Send announcement using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendCommunityAnnouncement(ctx, params.CommunityJID, params.Text)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The community does not exist or you are not a member",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Community not found"), nil
+		}
+		if errors.Is(err, client.ErrNotCommunity) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_A_COMMUNITY",
+					Message: "The group is a regular group, not a community",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not a community"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send community announcement",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send community announcement"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Announcement sent to community %s (announcement group %s). You are now subscribed to notifications from this chat.", params.CommunityJID, response.AnnouncementGroupJID)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+	"whatsmeow-mcp/internal/vcard"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendContactMessageTool creates and returns the send_contact_message MCP tool
+func SendContactMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_contact_message",
+		mcp.WithDescription("Share one or more contact cards (vCard) in a WhatsApp chat. Each contact is either built from 'name' plus 'phone' and/or 'email', or given as a raw 'vcard' string. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithArray("contacts",
+			mcp.Required(),
+			mcp.Description("Contacts to share. Several contacts are sent together as a single message"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Full name of the contact",
+					},
+					"phone": map[string]any{
+						"type":        "string",
+						"description": "Phone number in international format (e.g., +1234567890)",
+					},
+					"email": map[string]any{
+						"type":        "string",
+						"description": "Email address",
+					},
+					"organization": map[string]any{
+						"type":        "string",
+						"description": "Company or organization",
+					},
+					"vcard": map[string]any{
+						"type":        "string",
+						"description": "Raw vCard string (BEGIN:VCARD ... END:VCARD), used instead of the other fields",
+					},
+				},
+			}),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendContactMessage handles the send_contact_message tool execution
+func HandleSendContactMessage(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendContactMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" || len(params.Contacts) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'to' and 'contacts' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'to' and 'contacts'"), nil
+		}
+
+		// Build a vCard for every contact given by fields
+		vcards := make([]string, 0, len(params.Contacts))
+		for i, contact := range params.Contacts {
+			if contact.VCard != "" {
+				// Malformed cards are rejected here instead of failing as a send error
+				card, err := vcard.Parse(contact.VCard)
+				if err == nil && card.Name == "" {
+					err = fmt.Errorf("vCard has no name")
+				}
+				if err != nil {
+					result := types.StandardResponse{
+						Success: false,
+						Error: &types.ErrorInfo{
+							Code:    "INVALID_PARAMETERS",
+							Message: fmt.Sprintf("Contact %d has an invalid 'vcard'", i+1),
+							Details: err.Error(),
+						},
+					}
+					return mcp.NewToolResultStructured(result, "Invalid contact"), nil
+				}
+				vcards = append(vcards, contact.VCard)
+				continue
+			}
+
+			var phones, emails []string
+			if contact.Phone != "" {
+				phones = append(phones, contact.Phone)
+			}
+			if contact.Email != "" {
+				emails = append(emails, contact.Email)
+			}
+
+			card, err := vcard.Build(contact.Name, contact.Organization, phones, emails)
+			if err != nil {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "INVALID_PARAMETERS",
+						Message: fmt.Sprintf("Contact %d needs a 'name' and a 'phone' or 'email', or a 'vcard'", i+1),
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Invalid contact"), nil
+			}
+			vcards = append(vcards, card)
+		}
+
+		// Send contacts using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendContactMessage(ctx, params.To, vcards, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send contact message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send contact message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("%d contact(s) sent successfully to %s. You are now subscribed to notifications from this chat.", len(response.Contacts), params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendDocumentMessageTool creates and returns the send_document_message MCP tool
+func SendDocumentMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_document_message",
+		mcp.WithDescription("Send a file (PDF, spreadsheet, CSV, archive, etc.) as a WhatsApp document. Provide exactly one of 'document_base64', 'document_path' or 'document_url'. The MIME type is detected from content and filename, and the page count is set for PDFs. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithString("document_base64",
+			mcp.Description("Base64 encoded file content, optionally as a data URI"),
+		),
+		mcp.WithString("document_path",
+			mcp.Description("Path to a file on the server filesystem, inside the MEDIA_ROOT directory (relative paths are relative to it)"),
+		),
+		mcp.WithString("document_url",
+			mcp.Description("URL of a file served from this server's /static endpoint"),
+		),
+		mcp.WithString("filename",
+			mcp.Description("Optional filename shown to the recipient (e.g. 'report.pdf'). Defaults to the source file name"),
+		),
+		mcp.WithString("mimetype",
+			mcp.Description("Optional MIME type. Detected from content and filename if omitted"),
+		),
+		mcp.WithString("caption",
+			mcp.Description("Optional caption displayed with the document"),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendDocumentMessage handles the send_document_message tool execution
+func HandleSendDocumentMessage(whatsappClient client.WhatsAppClientInterface, mediaStore *media.Store) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendDocumentMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'to' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'to'"), nil
+		}
+
+		// Load document from the provided source
+		file, err := mediaStore.Load(params.DocumentBase64, params.DocumentPath, params.DocumentURL)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_MEDIA",
+					Message: "Provide exactly one readable file via 'document_base64', 'document_path' or 'document_url'",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to load document"), nil
+		}
+
+		// Use the source file name unless a custom filename is given
+		filename := params.Filename
+		if filename == "" {
+			filename = file.Filename
+		}
+
+		// Send document using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendDocumentMessage(ctx, params.To, file.Data, filename, params.MimeType, params.Caption, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send document message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send document message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Document %s sent successfully to %s. You are now subscribed to notifications from this chat.", response.FileName, params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
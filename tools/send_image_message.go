@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendImageMessageTool creates and returns the send_image_message MCP tool
+func SendImageMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_image_message",
+		mcp.WithDescription("Send an image (JPEG or PNG) to a WhatsApp chat or contact with an optional caption. Provide exactly one of 'image_base64', 'image_path' or 'image_url'. A thumbnail is generated automatically. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithString("image_base64",
+			mcp.Description("Base64 encoded image content, optionally as a data URI (e.g. 'data:image/png;base64,...')"),
+		),
+		mcp.WithString("image_path",
+			mcp.Description("Path to an image file on the server filesystem, inside the MEDIA_ROOT directory (relative paths are relative to it)"),
+		),
+		mcp.WithString("image_url",
+			mcp.Description("URL of an image served from this server's /static endpoint"),
+		),
+		mcp.WithString("caption",
+			mcp.Description("Optional caption displayed under the image"),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendImageMessage handles the send_image_message tool execution
+func HandleSendImageMessage(whatsappClient client.WhatsAppClientInterface, mediaStore *media.Store) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendImageMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'to' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'to'"), nil
+		}
+
+		// Load image from the provided source
+		file, err := mediaStore.Load(params.ImageBase64, params.ImagePath, params.ImageURL)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_MEDIA",
+					Message: "Provide exactly one readable image via 'image_base64', 'image_path' or 'image_url'",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to load image"), nil
+		}
+
+		// Send image using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendImageMessage(ctx, params.To, file.Data, params.Caption, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send image message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send image message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Image sent successfully to %s. You are now subscribed to notifications from this chat.", params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendLocationMessageTool creates and returns the send_location_message MCP tool
+func SendLocationMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_location_message",
+		mcp.WithDescription("Send a location pin to a WhatsApp chat or contact, optionally with the name and address of the place. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithNumber("latitude",
+			mcp.Required(),
+			mcp.Description("Latitude in degrees, between -90 and 90 (e.g., 52.520008)"),
+		),
+		mcp.WithNumber("longitude",
+			mcp.Required(),
+			mcp.Description("Longitude in degrees, between -180 and 180 (e.g., 13.404954)"),
+		),
+		mcp.WithString("name",
+			mcp.Description("Optional name of the place (e.g., 'Brandenburg Gate')"),
+		),
+		mcp.WithString("address",
+			mcp.Description("Optional address of the place (e.g., 'Pariser Platz, 10117 Berlin')"),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendLocationMessage handles the send_location_message tool execution
+func HandleSendLocationMessage(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendLocationMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" || params.Latitude == nil || params.Longitude == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'to', 'latitude' and 'longitude' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'to', 'latitude' and 'longitude'"), nil
+		}
+
+		// Validate coordinate ranges
+		if *params.Latitude < -90 || *params.Latitude > 90 || *params.Longitude < -180 || *params.Longitude > 180 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Latitude must be between -90 and 90 and longitude between -180 and 180",
+					Details: fmt.Sprintf("latitude=%f, longitude=%f", *params.Latitude, *params.Longitude),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid coordinates"), nil
+		}
+
+		// Send location using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendLocationMessage(ctx, params.To, *params.Latitude, *params.Longitude, params.Name, params.Address, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send location message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send location message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Location sent successfully to %s. You are now subscribed to notifications from this chat.", params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -3,7 +3,9 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 	"whatsmeow-mcp/internal/client"
 	"whatsmeow-mcp/internal/types"
 
@@ -25,6 +27,9 @@ func SendMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
 		mcp.WithString("quoted_message_id",
 			mcp.Description("Optional ID of a previous message to reply to/quote"),
 		),
+		mcp.WithBoolean("simulate_typing",
+			mcp.Description("Show 'typing…' in the chat before sending, for a time proportional to the text length (1 to 10 seconds), so the reply does not arrive instantly"),
+		),
 	)
 
 	return tool
@@ -71,6 +76,46 @@ func HandleSendMessage(whatsappClient client.WhatsAppClientInterface) func(ctx c
 			return mcp.NewToolResultStructured(result, "Missing required parameters: 'to' and 'text'"), nil
 		}
 
+		// Show the typing indicator first if requested
+		var typingDuration time.Duration
+		if params.SimulateTyping {
+			var err error
+			typingDuration, err = whatsappClient.SimulateTyping(ctx, params.To, params.Text)
+			if errors.Is(err, client.ErrInvalidChat) {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "INVALID_PARAMETERS",
+						Message: "Parameter 'to' must be a user or group JID",
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Invalid recipient"), nil
+			}
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "SEND_FAILED",
+						Message: "Message was not sent, typing simulation was interrupted",
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Message was not sent, typing simulation was interrupted"), nil
+			}
+			if err != nil {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "PRESENCE_FAILED",
+						Message: "Message was not sent, the typing indicator could not be shown",
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Message was not sent, typing simulation failed"), nil
+			}
+		}
+
 		// Send message using client interface (context contains session for auto-subscription)
 		response, err := whatsappClient.SendMessage(ctx, params.To, params.Text, params.QuotedMessageID)
 		if err != nil {
@@ -85,6 +130,7 @@ func HandleSendMessage(whatsappClient client.WhatsAppClientInterface) func(ctx c
 			return mcp.NewToolResultStructured(result, "Failed to send message"), nil
 		}
 
+		response.TypingMs = typingDuration.Milliseconds()
 		result := response
 
 		// Create fallback text for backward compatibility
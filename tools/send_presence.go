@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendPresenceTool creates and returns the send_presence MCP tool
+func SendPresenceTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_presence",
+		mcp.WithDescription("Set your global WhatsApp presence to 'available' (online) or 'unavailable' (offline). The presence is kept across reconnects. While unavailable, read receipts are not sent and presence updates of other users are not received. Requires authentication."),
+		mcp.WithString("presence",
+			mcp.Required(),
+			mcp.Description("Presence to set: 'available' (online) or 'unavailable' (offline)"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendPresence handles the send_presence tool execution
+func HandleSendPresence(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendPresenceParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Presence == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'presence' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'presence'"), nil
+		}
+
+		// Validate presence value
+		if params.Presence != "available" && params.Presence != "unavailable" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Parameter 'presence' must be 'available' or 'unavailable'",
+					Details: fmt.Sprintf("presence=%s", params.Presence),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Invalid presence"), nil
+		}
+
+		// Set presence using client interface
+		response, err := whatsappClient.SendPresence(params.Presence)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send presence",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send presence"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Presence set to %s.", response.Presence)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
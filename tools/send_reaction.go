@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendReactionTool creates and returns the send_reaction MCP tool
+func SendReactionTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_reaction",
+		mcp.WithDescription("React to a WhatsApp message with an emoji, or remove your reaction with 'remove'. Reacting again replaces the previous reaction. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("chat",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the chat containing the message (e.g., '1234567890@s.whatsapp.net' or a group JID ending with '@g.us')"),
+		),
+		mcp.WithString("message_id",
+			mcp.Required(),
+			mcp.Description("ID of the message to react to"),
+		),
+		mcp.WithString("emoji",
+			mcp.Description("Emoji to react with (e.g., '👍', '❤️'). Required unless 'remove' is true"),
+		),
+		mcp.WithBoolean("remove",
+			mcp.Description("Remove your existing reaction from the message"),
+		),
+		mcp.WithString("sender",
+			mcp.Description("Optional JID of the message author. Only needed if the message is not in the stored chat history"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendReaction handles the send_reaction tool execution
+func HandleSendReaction(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendReactionParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.Chat == "" || params.MessageID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'chat' and 'message_id' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'chat' and 'message_id'"), nil
+		}
+
+		// Either react with an emoji or remove the reaction
+		emoji := params.Emoji
+		if params.Remove {
+			emoji = ""
+		} else if emoji == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Parameter 'emoji' must be provided unless 'remove' is true",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'emoji'"), nil
+		}
+
+		// Send reaction using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendReaction(ctx, params.Chat, params.MessageID, params.Sender, emoji)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send reaction",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send reaction"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Reacted with %s to message %s.", emoji, params.MessageID)
+		if response.Removed {
+			fallbackText = fmt.Sprintf("Reaction removed from message %s.", params.MessageID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,147 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SendVideoMessageTool creates and returns the send_video_message MCP tool
+func SendVideoMessageTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("send_video_message",
+		mcp.WithDescription("Send an MP4 video with an optional caption, or as a looping GIF with 'gif_playback'. Provide exactly one of 'video_base64', 'video_path' or 'video_url'. Dimensions and duration are read from the file. Pass a frame image via 'thumbnail_base64' or 'thumbnail_path' for a preview thumbnail, otherwise a placeholder is generated. Requires authentication. Like send_message, your session is automatically subscribed to notifications from this chat."),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID (recipient identifier) in format 'phonenumber@s.whatsapp.net' (e.g., '1234567890@s.whatsapp.net') or group JID ending with '@g.us'"),
+		),
+		mcp.WithString("video_base64",
+			mcp.Description("Base64 encoded MP4 video content, optionally as a data URI"),
+		),
+		mcp.WithString("video_path",
+			mcp.Description("Path to an MP4 video file on the server filesystem, inside the MEDIA_ROOT directory (relative paths are relative to it)"),
+		),
+		mcp.WithString("video_url",
+			mcp.Description("URL of an MP4 video served from this server's /static endpoint"),
+		),
+		mcp.WithString("thumbnail_base64",
+			mcp.Description("Optional base64 encoded JPEG/PNG frame used to generate the thumbnail"),
+		),
+		mcp.WithString("thumbnail_path",
+			mcp.Description("Optional path to a JPEG/PNG frame inside the MEDIA_ROOT directory used to generate the thumbnail"),
+		),
+		mcp.WithString("caption",
+			mcp.Description("Optional caption displayed under the video"),
+		),
+		mcp.WithBoolean("gif_playback",
+			mcp.Description("Play the video as a looping, muted GIF (default: false)"),
+		),
+		mcp.WithString("quoted_message_id",
+			mcp.Description("Optional ID of a previous message to reply to/quote"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSendVideoMessage handles the send_video_message tool execution
+func HandleSendVideoMessage(whatsappClient client.WhatsAppClientInterface, mediaStore *media.Store) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SendVideoMessageParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.To == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'to' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'to'"), nil
+		}
+
+		// Load video from the provided source
+		file, err := mediaStore.Load(params.VideoBase64, params.VideoPath, params.VideoURL)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_MEDIA",
+					Message: "Provide exactly one readable video via 'video_base64', 'video_path' or 'video_url'",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to load video"), nil
+		}
+
+		// Load optional thumbnail frame
+		var thumbnailImage []byte
+		if params.ThumbnailBase64 != "" || params.ThumbnailPath != "" {
+			thumbnail, err := mediaStore.Load(params.ThumbnailBase64, params.ThumbnailPath, "")
+			if err != nil {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "INVALID_MEDIA",
+						Message: "Provide at most one readable thumbnail via 'thumbnail_base64' or 'thumbnail_path'",
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Failed to load thumbnail"), nil
+			}
+			thumbnailImage = thumbnail.Data
+		}
+
+		// Send video using client interface (context contains session for auto-subscription)
+		response, err := whatsappClient.SendVideoMessage(ctx, params.To, file.Data, thumbnailImage, params.Caption, params.GifPlayback, params.QuotedMessageID)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SEND_FAILED",
+					Message: "Failed to send video message",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to send video message"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		kind := "Video"
+		if response.GifPlayback {
+			kind = "GIF"
+		}
+		fallbackText := fmt.Sprintf("%s sent successfully to %s. You are now subscribed to notifications from this chat.", kind, params.To)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
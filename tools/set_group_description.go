@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SetGroupDescriptionTool creates and returns the set_group_description MCP tool
+func SetGroupDescriptionTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("set_group_description",
+		mcp.WithDescription("Change or remove the description of a WhatsApp group. Depending on the group settings, only admins may change it. Requires authentication."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New group description. Leave empty to remove the current description"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSetGroupDescription handles the set_group_description tool execution
+func HandleSetGroupDescription(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SetGroupDescriptionParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'group_jid'"), nil
+		}
+
+		// Change description using client interface
+		response, err := whatsappClient.SetGroupDescription(ctx, params.GroupJID, params.Description)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to set group description",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to set group description"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Description of group %s updated.", params.GroupJID)
+		if params.Description == "" {
+			fallbackText = fmt.Sprintf("Description of group %s removed.", params.GroupJID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SetGroupJoinApprovalTool creates and returns the set_group_join_approval MCP tool
+func SetGroupJoinApprovalTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("set_group_join_approval",
+		mcp.WithDescription("Turn admin approval for new members of a WhatsApp group on or off. While enabled, people joining via invite link only create a join request. Requires authentication and admin rights in the group. Your session is automatically subscribed to 'join_request' notifications from the group."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithBoolean("enabled",
+			mcp.Required(),
+			mcp.Description("true to require admin approval for new members, false to let anyone with the invite link join directly"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSetGroupJoinApproval handles the set_group_join_approval tool execution
+func HandleSetGroupJoinApproval(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SetGroupJoinApprovalParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" || params.Enabled == nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameters 'group_jid' and 'enabled' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'group_jid' and 'enabled'"), nil
+		}
+
+		// Change approval mode using client interface
+		response, err := whatsappClient.SetGroupJoinApproval(ctx, params.GroupJID, *params.Enabled)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to set join approval mode",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to set join approval mode"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Join approval disabled for group %s.", params.GroupJID)
+		if response.JoinApprovalRequired {
+			fallbackText = fmt.Sprintf("Join approval enabled for group %s, new members need admin approval.", params.GroupJID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/media"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SetGroupPhotoTool creates and returns the set_group_photo MCP tool
+func SetGroupPhotoTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("set_group_photo",
+		mcp.WithDescription("Set or remove the photo of a WhatsApp group. Provide exactly one of 'image_base64', 'image_path' or 'image_url' (JPEG or PNG), or set 'remove' to delete the current photo. The image is cropped to a square and converted to JPEG automatically. Requires authentication."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithString("image_base64",
+			mcp.Description("Base64 encoded image content, optionally as a data URI (e.g. 'data:image/png;base64,...')"),
+		),
+		mcp.WithString("image_path",
+			mcp.Description("Path to an image file on the server filesystem, inside the MEDIA_ROOT directory (relative paths are relative to it)"),
+		),
+		mcp.WithString("image_url",
+			mcp.Description("URL of an image served from this server's /static endpoint"),
+		),
+		mcp.WithBoolean("remove",
+			mcp.Description("Remove the current group photo instead of setting a new one"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSetGroupPhoto handles the set_group_photo tool execution
+func HandleSetGroupPhoto(whatsappClient client.WhatsAppClientInterface, mediaStore *media.Store) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SetGroupPhotoParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'group_jid'"), nil
+		}
+
+		// Load image from the provided source unless the photo is removed
+		var data []byte
+		if !params.Remove {
+			file, err := mediaStore.Load(params.ImageBase64, params.ImagePath, params.ImageURL)
+			if err != nil {
+				result := types.StandardResponse{
+					Success: false,
+					Error: &types.ErrorInfo{
+						Code:    "INVALID_MEDIA",
+						Message: "Provide exactly one readable image via 'image_base64', 'image_path' or 'image_url', or set 'remove'",
+						Details: err.Error(),
+					},
+				}
+				return mcp.NewToolResultStructured(result, "Failed to load image"), nil
+			}
+			data = file.Data
+		}
+
+		// Change photo using client interface
+		response, err := whatsappClient.SetGroupPhoto(ctx, params.GroupJID, data)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to set group photo",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to set group photo"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Photo of group %s updated.", params.GroupJID)
+		if response.Removed {
+			fallbackText = fmt.Sprintf("Photo of group %s removed.", params.GroupJID)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
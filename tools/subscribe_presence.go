@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SubscribePresenceTool creates and returns the subscribe_presence MCP tool
+func SubscribePresenceTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("subscribe_presence",
+		mcp.WithDescription("Subscribe to the online status of a WhatsApp user. Your session then receives notifications when the user comes online or goes offline, and when they start or stop typing or recording in their chat with you. Returns what is already known about the user. Requires authentication and your presence to be 'available'."),
+		mcp.WithString("jid",
+			mcp.Required(),
+			mcp.Description("JID of the user (e.g., '1234567890@s.whatsapp.net'); phone numbers in international format are accepted as well"),
+		),
+	)
+
+	return tool
+}
+
+// HandleSubscribePresence handles the subscribe_presence tool execution
+func HandleSubscribePresence(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.SubscribePresenceParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.JID == "" {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'jid' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameter: 'jid'"), nil
+		}
+
+		// Subscribe to presence using client interface (context contains session for subscription)
+		response, err := whatsappClient.SubscribePresence(ctx, params.JID)
+		if errors.Is(err, client.ErrPresenceUnavailable) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "PRESENCE_UNAVAILABLE",
+					Message: "Your presence is unavailable. Set it to 'available' with send_presence to receive presence updates",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Presence unavailable, use send_presence first"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "SUBSCRIBE_FAILED",
+					Message: "Failed to subscribe to presence",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to subscribe to presence"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Subscribed to presence updates of %s.", response.JID)
+		if response.Presence != nil {
+			if response.Presence.Available {
+				fallbackText += " Currently online."
+			} else if response.Presence.LastSeen > 0 {
+				fallbackText += fmt.Sprintf(" Last seen %s.", time.Unix(response.Presence.LastSeen, 0).UTC().Format(time.RFC3339))
+			} else {
+				fallbackText += " Currently offline."
+			}
+		}
+		if response.ChatState != nil && response.ChatState.State != types.ChatStatePaused {
+			fallbackText += fmt.Sprintf(" Currently %s.", response.ChatState.State)
+		}
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
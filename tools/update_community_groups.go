@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// UpdateCommunityGroupsTool creates and returns the update_community_groups MCP tool
+func UpdateCommunityGroupsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("update_community_groups",
+		mcp.WithDescription("Link existing WhatsApp groups to a community or unlink them from it, in batch. The result reports success or the failure reason for every group. Requires authentication and admin rights in the community and the groups."),
+		mcp.WithString("community_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the community, ending with '@g.us' (e.g., '120363012345678901@g.us')"),
+		),
+		mcp.WithArray("link",
+			mcp.Description("JIDs of existing groups to add to the community"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("unlink",
+			mcp.Description("JIDs of groups to remove from the community"),
+			mcp.WithStringItems(),
+		),
+	)
+
+	return tool
+}
+
+// HandleUpdateCommunityGroups handles the update_community_groups tool execution
+func HandleUpdateCommunityGroups(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.UpdateCommunityGroupsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.CommunityJID == "" || len(params.Link)+len(params.Unlink) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'community_jid' and at least one of 'link' or 'unlink' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'community_jid' and groups to link or unlink"), nil
+		}
+
+		// Link and unlink groups using client interface
+		response, err := whatsappClient.UpdateCommunityGroups(ctx, params.CommunityJID, params.Link, params.Unlink)
+		if errors.Is(err, client.ErrGroupNotFound) || errors.Is(err, client.ErrNotInGroup) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "GROUP_NOT_FOUND",
+					Message: "The community does not exist or you are not a member",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Community not found"), nil
+		}
+		if errors.Is(err, client.ErrNotCommunity) {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_A_COMMUNITY",
+					Message: "The group is a regular group, not a community",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not a community"), nil
+		}
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update community groups",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update community groups"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Updated groups of community %s: %d change(s) succeeded, %d failed.", params.CommunityJID, response.Succeeded, response.Failed)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// UpdateGroupJoinRequestsTool creates and returns the update_group_join_requests MCP tool
+func UpdateGroupJoinRequestsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("update_group_join_requests",
+		mcp.WithDescription("Approve or reject pending requests to join a WhatsApp group, in batch. The result reports success or the failure reason for every requester. Requires authentication and admin rights in the group. Your session is automatically subscribed to 'join_request' notifications from the group."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithArray("approve",
+			mcp.Description("JIDs of requesters to let into the group"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("reject",
+			mcp.Description("JIDs of requesters to turn down"),
+			mcp.WithStringItems(),
+		),
+	)
+
+	return tool
+}
+
+// HandleUpdateGroupJoinRequests handles the update_group_join_requests tool execution
+func HandleUpdateGroupJoinRequests(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.UpdateGroupJoinRequestsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" || len(params.Approve)+len(params.Reject) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' and at least one of 'approve' or 'reject' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'group_jid' and 'approve' or 'reject'"), nil
+		}
+
+		// Handle join requests using client interface
+		response, err := whatsappClient.UpdateGroupJoinRequests(ctx, params.GroupJID, params.Approve, params.Reject)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update join requests",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update join requests"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Handled join requests of group %s: %d succeeded, %d failed.", params.GroupJID, response.Succeeded, response.Failed)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}
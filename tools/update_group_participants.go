@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"whatsmeow-mcp/internal/client"
+	"whatsmeow-mcp/internal/types"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// UpdateGroupParticipantsTool creates and returns the update_group_participants MCP tool
+func UpdateGroupParticipantsTool(whatsappClient client.WhatsAppClientInterface) mcp.Tool {
+	tool := mcp.NewTool("update_group_participants",
+		mcp.WithDescription("Add, remove, promote and demote participants of a WhatsApp group in a single call. Changes are applied in the order add, promote, demote, remove, so a newly added participant can be made admin right away. The result reports success or the failure reason for every participant. Requires authentication and admin rights in the group."),
+		mcp.WithString("group_jid",
+			mcp.Required(),
+			mcp.Description("WhatsApp JID of the group, ending with '@g.us' (e.g., '123456789-987654321@g.us')"),
+		),
+		mcp.WithArray("add",
+			mcp.Description("JIDs of participants to add (e.g., '1234567890@s.whatsapp.net')"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("remove",
+			mcp.Description("JIDs of participants to remove"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("promote",
+			mcp.Description("JIDs of participants to make admin"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("demote",
+			mcp.Description("JIDs of admins to make regular participants"),
+			mcp.WithStringItems(),
+		),
+	)
+
+	return tool
+}
+
+// HandleUpdateGroupParticipants handles the update_group_participants tool execution
+func HandleUpdateGroupParticipants(whatsappClient client.WhatsAppClientInterface) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var params types.UpdateGroupParticipantsParams
+		argumentsBytes, _ := json.Marshal(request.Params.Arguments)
+		if err := json.Unmarshal(argumentsBytes, &params); err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "INVALID_PARAMETERS",
+					Message: "Failed to parse parameters",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to parse parameters"), nil
+		}
+
+		// Check if user is authenticated
+		if !whatsappClient.IsLoggedIn() {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "NOT_LOGGED_IN",
+					Message: "Client is not authenticated. Please login first using get_qr_code tool.",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Not authenticated. Please login first."), nil
+		}
+
+		// Validate required parameters
+		if params.GroupJID == "" || len(params.Add)+len(params.Remove)+len(params.Promote)+len(params.Demote) == 0 {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "MISSING_PARAMETERS",
+					Message: "Required parameter 'group_jid' and at least one of 'add', 'remove', 'promote' or 'demote' must be provided",
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Missing required parameters: 'group_jid' and participant changes"), nil
+		}
+
+		// Apply participant changes using client interface
+		response, err := whatsappClient.UpdateGroupParticipants(ctx, params.GroupJID, params.Add, params.Remove, params.Promote, params.Demote)
+		if err != nil {
+			result := types.StandardResponse{
+				Success: false,
+				Error: &types.ErrorInfo{
+					Code:    "UPDATE_FAILED",
+					Message: "Failed to update group participants",
+					Details: err.Error(),
+				},
+			}
+			return mcp.NewToolResultStructured(result, "Failed to update group participants"), nil
+		}
+
+		// Create fallback text for backward compatibility
+		fallbackText := fmt.Sprintf("Updated participants of group %s: %d change(s) succeeded, %d failed.", params.GroupJID, response.Succeeded, response.Failed)
+
+		return mcp.NewToolResultStructured(response, fallbackText), nil
+	}
+}